@@ -0,0 +1,166 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Linux native AIO
+
+package unix
+
+import "unsafe"
+
+type aioRequest struct {
+	iocb *Iocb
+	buf  []byte
+}
+
+// AioContext is a Linux native AIO context that batches I/O control blocks
+// and keeps the buffers of submitted requests alive until their completion
+// events have been reaped. An AioContext must not be used concurrently from
+// multiple goroutines.
+//
+// Native AIO is only asynchronous for files opened with O_DIRECT. For such
+// files the buffer address, the length and the file offset of each request
+// must be aligned to the logical block size of the underlying device;
+// memory returned by Mmap with MAP_ANONYMOUS is suitably aligned.
+type AioContext struct {
+	ctx      uintptr
+	resfd    int
+	pending  []*Iocb
+	inflight map[uint64]aioRequest
+}
+
+// NewAioContext creates an AIO context able to hold at least nrEvents
+// requests in flight.
+func NewAioContext(nrEvents int) (*AioContext, error) {
+	var ctx uintptr
+	if err := IoSetup(nrEvents, &ctx); err != nil {
+		return nil, err
+	}
+	return &AioContext{
+		ctx:      ctx,
+		resfd:    -1,
+		inflight: make(map[uint64]aioRequest),
+	}, nil
+}
+
+// Context returns the underlying aio_context_t, for use with IoSubmit,
+// IoGetevents and IoCancel.
+func (c *AioContext) Context() uintptr {
+	return c.ctx
+}
+
+// SetEventfd arranges for the completion of every request prepared from now
+// on to be signalled on the eventfd fd using IOCB_FLAG_RESFD. The eventfd
+// counter is incremented once per completion, so it can be waited for with
+// EpollWait or Poll. Passing -1 turns notification off again.
+func (c *AioContext) SetEventfd(fd int) {
+	c.resfd = fd
+}
+
+func (c *AioContext) prep(op uint16, fd int, p []byte, offset int64, data uint64) *Iocb {
+	iocb := &Iocb{
+		Data:       data,
+		Lio_opcode: op,
+		Fildes:     uint32(fd),
+		Nbytes:     uint64(len(p)),
+		Offset:     offset,
+	}
+	if len(p) > 0 {
+		iocb.Buf = uint64(uintptr(unsafe.Pointer(&p[0])))
+	}
+	if c.resfd >= 0 {
+		iocb.Flags |= IOCB_FLAG_RESFD
+		iocb.Resfd = uint32(c.resfd)
+	}
+	c.pending = append(c.pending, iocb)
+	c.inflight[iocbKey(iocb)] = aioRequest{iocb, p}
+	return iocb
+}
+
+func iocbKey(iocb *Iocb) uint64 {
+	return uint64(uintptr(unsafe.Pointer(iocb)))
+}
+
+// PrepPread adds a read of len(p) bytes from fd at offset into p to the
+// batch. data is passed back in the Data field of the completion event.
+func (c *AioContext) PrepPread(fd int, p []byte, offset int64, data uint64) *Iocb {
+	return c.prep(IOCB_CMD_PREAD, fd, p, offset, data)
+}
+
+// PrepPwrite adds a write of p to fd at offset to the batch. data is passed
+// back in the Data field of the completion event.
+func (c *AioContext) PrepPwrite(fd int, p []byte, offset int64, data uint64) *Iocb {
+	return c.prep(IOCB_CMD_PWRITE, fd, p, offset, data)
+}
+
+// PrepFsync adds an fsync of fd to the batch.
+func (c *AioContext) PrepFsync(fd int, data uint64) *Iocb {
+	return c.prep(IOCB_CMD_FSYNC, fd, nil, 0, data)
+}
+
+// PrepFdatasync adds an fdatasync of fd to the batch.
+func (c *AioContext) PrepFdatasync(fd int, data uint64) *Iocb {
+	return c.prep(IOCB_CMD_FDSYNC, fd, nil, 0, data)
+}
+
+// Pending returns the number of prepared requests that have not been
+// submitted yet.
+func (c *AioContext) Pending() int {
+	return len(c.pending)
+}
+
+// Submit passes the prepared requests to the kernel with a single io_submit
+// call and returns the number of requests it accepted. Requests the kernel
+// did not accept remain queued for the next call to Submit, except that a
+// request rejected with an error other than EAGAIN or EINTR is dropped from
+// the batch, as retrying it would fail again.
+func (c *AioContext) Submit() (int, error) {
+	n, err := IoSubmit(c.ctx, c.pending)
+	if err != nil {
+		n = 0
+		if err != EAGAIN && err != EINTR && len(c.pending) > 0 {
+			delete(c.inflight, iocbKey(c.pending[0]))
+			n = 1
+		}
+	}
+	rest := copy(c.pending, c.pending[n:])
+	for i := rest; i < len(c.pending); i++ {
+		c.pending[i] = nil
+	}
+	c.pending = c.pending[:rest]
+	if err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// GetEvents waits for at least minNr and at most len(events) completion
+// events and returns the number of events stored in events. A nil timeout
+// waits indefinitely. The buffers of the completed requests are released.
+func (c *AioContext) GetEvents(minNr int, events []IoEvent, timeout *Timespec) (int, error) {
+	n, err := IoGetevents(c.ctx, minNr, events, timeout)
+	for i := 0; i < n; i++ {
+		delete(c.inflight, events[i].Obj)
+	}
+	return n, err
+}
+
+// Cancel attempts to cancel the submitted request iocb. If the request was
+// cancelled synchronously, its completion event is returned.
+func (c *AioContext) Cancel(iocb *Iocb) (IoEvent, error) {
+	var ev IoEvent
+	if err := IoCancel(c.ctx, iocb, &ev); err != nil {
+		return ev, err
+	}
+	delete(c.inflight, iocbKey(iocb))
+	return ev, nil
+}
+
+// Destroy waits for all submitted requests to finish and destroys the
+// context. Requests that were prepared but not submitted are discarded.
+func (c *AioContext) Destroy() error {
+	err := IoDestroy(c.ctx)
+	c.pending = nil
+	c.inflight = nil
+	return err
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestAioContext(t *testing.T) {
+	c, err := unix.NewAioContext(8)
+	if err == unix.ENOSYS {
+		t.Skip("native AIO not available")
+	}
+	if err != nil {
+		t.Fatalf("NewAioContext: %v", err)
+	}
+	defer c.Destroy()
+
+	efd, err := unix.Eventfd(0, unix.EFD_CLOEXEC)
+	if err != nil {
+		t.Fatalf("Eventfd: %v", err)
+	}
+	defer unix.Close(efd)
+	c.SetEventfd(efd)
+
+	f, err := ioutil.TempFile("", "TestAioContext")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	fd := int(f.Fd())
+
+	want := []byte("native aio")
+	c.PrepPwrite(fd, want[:6], 0, 1)
+	c.PrepPwrite(fd, want[6:], 6, 2)
+	if n, err := c.Submit(); err != nil || n != 2 {
+		t.Fatalf("Submit: %d, %v", n, err)
+	}
+	if c.Pending() != 0 {
+		t.Errorf("Pending = %d after Submit, want 0", c.Pending())
+	}
+
+	events := make([]unix.IoEvent, 4)
+	n, err := c.GetEvents(2, events, nil)
+	if err != nil || n != 2 {
+		t.Fatalf("GetEvents: %d, %v", n, err)
+	}
+	for _, ev := range events[:n] {
+		if ev.Res < 0 {
+			t.Errorf("request %d failed: %v", ev.Data, unix.Errno(-ev.Res))
+		}
+	}
+
+	var cnt uint64
+	if _, err := unix.Read(efd, (*[8]byte)(unsafe.Pointer(&cnt))[:]); err != nil {
+		t.Fatalf("Read eventfd: %v", err)
+	}
+	if cnt != 2 {
+		t.Errorf("eventfd counter = %d, want 2", cnt)
+	}
+
+	got := make([]byte, 64)
+	c.PrepPread(fd, got, 0, 3)
+	if _, err := c.Submit(); err != nil {
+		t.Fatalf("Submit: %v", err)
+	}
+	if n, err := c.GetEvents(1, events, nil); err != nil || n != 1 {
+		t.Fatalf("GetEvents: %d, %v", n, err)
+	}
+	if events[0].Data != 3 || events[0].Res != int64(len(want)) {
+		t.Fatalf("read event = %+v, want data 3 and res %d", events[0], len(want))
+	}
+	if got = got[:events[0].Res]; !bytes.Equal(got, want) {
+		t.Errorf("read %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// eBPF maps, programs and pinned objects
+
+package unix
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// SetRegs sets the destination and source registers of the instruction i.
+func (i *BpfInsn) SetRegs(dst, src uint8) {
+	if isBigEndian {
+		i.Regs = dst<<4 | src&0xf
+	} else {
+		i.Regs = src<<4 | dst&0xf
+	}
+}
+
+// DstReg returns the destination register of the instruction i.
+func (i *BpfInsn) DstReg() uint8 {
+	if isBigEndian {
+		return i.Regs >> 4
+	}
+	return i.Regs & 0xf
+}
+
+// SrcReg returns the source register of the instruction i.
+func (i *BpfInsn) SrcReg() uint8 {
+	if isBigEndian {
+		return i.Regs & 0xf
+	}
+	return i.Regs >> 4
+}
+
+func bpfAddr(p unsafe.Pointer) uint64 {
+	return uint64(uintptr(p))
+}
+
+func bpfBytes(b []byte) uint64 {
+	if len(b) == 0 {
+		return 0
+	}
+	return bpfAddr(unsafe.Pointer(&b[0]))
+}
+
+// BpfMapCreate creates a map as described by attr and returns its file
+// descriptor.
+func BpfMapCreate(attr *BpfMapCreateAttr) (fd int, err error) {
+	return Bpf(BPF_MAP_CREATE, unsafe.Pointer(attr), SizeofBpfMapCreateAttr)
+}
+
+// BpfMapGetInfo returns the type, key and value sizes and other
+// properties of the map fd, using BPF_OBJ_GET_INFO_BY_FD.
+func BpfMapGetInfo(fd int) (*BpfMapInfo, error) {
+	var info BpfMapInfo
+	attr := BpfInfoAttr{
+		Bpf_fd:   uint32(fd),
+		Info_len: SizeofBpfMapInfo,
+		Info:     bpfAddr(unsafe.Pointer(&info)),
+	}
+	_, err := Bpf(BPF_OBJ_GET_INFO_BY_FD, unsafe.Pointer(&attr), SizeofBpfInfoAttr)
+	return &info, err
+}
+
+// bpfPossibleCPUs returns the number of possible CPUs, which is the number
+// of values per-CPU maps hold for each key.
+func bpfPossibleCPUs() (int, error) {
+	fd, err := Open("/sys/devices/system/cpu/possible", O_RDONLY|O_CLOEXEC, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer Close(fd)
+	var buf [256]byte
+	n, err := Read(fd, buf[:])
+	if err != nil {
+		return 0, err
+	}
+	// The file holds a list of ranges such as "0-3,8-11\n".
+	list := buf[:n]
+	if n > 0 && list[n-1] == '\n' {
+		list = list[:n-1]
+	}
+	cpus, lo, hi := 0, -1, 0
+	for _, c := range append(list, ',') {
+		switch {
+		case '0' <= c && c <= '9':
+			hi = hi*10 + int(c-'0')
+		case c == '-' && lo < 0:
+			lo, hi = hi, 0
+		case c == ',':
+			if lo < 0 {
+				lo = hi
+			}
+			if hi < lo {
+				return 0, EINVAL
+			}
+			cpus += hi - lo + 1
+			lo, hi = -1, 0
+		default:
+			return 0, EINVAL
+		}
+	}
+	if cpus == 0 {
+		return 0, EINVAL
+	}
+	return cpus, nil
+}
+
+// bpfMapValueSize returns the number of bytes the kernel copies to or from
+// a value of the map described by info.
+func bpfMapValueSize(info *BpfMapInfo) (int, error) {
+	switch info.Type {
+	case BPF_MAP_TYPE_PERCPU_HASH, BPF_MAP_TYPE_PERCPU_ARRAY,
+		BPF_MAP_TYPE_LRU_PERCPU_HASH, BPF_MAP_TYPE_PERCPU_CGROUP_STORAGE:
+		cpus, err := bpfPossibleCPUs()
+		if err != nil {
+			return 0, err
+		}
+		return int(info.Value_size+7) &^ 7 * cpus, nil
+	}
+	return int(info.Value_size), nil
+}
+
+func bpfMapElem(cmd int, fd int, key, value []byte, flags uint64) error {
+	// The kernel copies as many bytes as the map's key and value sizes
+	// from and to the buffers, whatever their length.
+	info, err := BpfMapGetInfo(fd)
+	if err != nil {
+		return err
+	}
+	if len(key) < int(info.Key_size) && !(cmd == BPF_MAP_GET_NEXT_KEY && key == nil) {
+		return EINVAL
+	}
+	switch cmd {
+	case BPF_MAP_LOOKUP_ELEM, BPF_MAP_UPDATE_ELEM:
+		size, err := bpfMapValueSize(info)
+		if err != nil {
+			return err
+		}
+		if len(value) < size {
+			return EINVAL
+		}
+	case BPF_MAP_GET_NEXT_KEY:
+		if len(value) < int(info.Key_size) {
+			return EINVAL
+		}
+	}
+
+	attr := BpfMapElemAttr{
+		Fd:    uint32(fd),
+		Key:   bpfBytes(key),
+		Value: bpfBytes(value),
+		Flags: flags,
+	}
+	_, err = Bpf(cmd, unsafe.Pointer(&attr), SizeofBpfMapElemAttr)
+	runtime.KeepAlive(key)
+	runtime.KeepAlive(value)
+	return err
+}
+
+// BpfMapLookupElem copies the value stored under key in the map fd into
+// value, which must be at least as long as the value size of the map. For
+// per-CPU maps, value must hold the value size rounded up to a multiple of
+// 8 bytes for each possible CPU. It returns EINVAL if key or value is too
+// short, and ENOENT if the map holds no such key.
+func BpfMapLookupElem(fd int, key, value []byte) error {
+	return bpfMapElem(BPF_MAP_LOOKUP_ELEM, fd, key, value, 0)
+}
+
+// BpfMapUpdateElem stores value under key in the map fd. flags is one of
+// BPF_ANY, BPF_NOEXIST and BPF_EXIST. key and value must be as long as for
+// BpfMapLookupElem.
+func BpfMapUpdateElem(fd int, key, value []byte, flags uint64) error {
+	return bpfMapElem(BPF_MAP_UPDATE_ELEM, fd, key, value, flags)
+}
+
+// BpfMapDeleteElem removes key from the map fd.
+func BpfMapDeleteElem(fd int, key []byte) error {
+	return bpfMapElem(BPF_MAP_DELETE_ELEM, fd, key, nil, 0)
+}
+
+// BpfMapGetNextKey stores the key following key in the map fd into
+// nextKey, which must be at least as long as the key size of the map. A nil
+// key retrieves the first key. It returns ENOENT after the last key.
+func BpfMapGetNextKey(fd int, key, nextKey []byte) error {
+	return bpfMapElem(BPF_MAP_GET_NEXT_KEY, fd, key, nextKey, 0)
+}
+
+// BpfProgLoad verifies and loads the program insns of type progType, one
+// of the BPF_PROG_TYPE_* values, and returns its file descriptor. license
+// determines which kernel helpers the program may call; "GPL" allows all.
+// If log is not empty, the verifier writes its log into it as a
+// NUL-terminated string, and the load fails with ENOSPC if log is too
+// small to hold it.
+func BpfProgLoad(progType int, insns []BpfInsn, license string, log []byte) (fd int, err error) {
+	if len(insns) == 0 {
+		return -1, EINVAL
+	}
+	lic, err := BytePtrFromString(license)
+	if err != nil {
+		return -1, err
+	}
+	attr := BpfProgLoadAttr{
+		Prog_type: uint32(progType),
+		Insn_cnt:  uint32(len(insns)),
+		Insns:     bpfAddr(unsafe.Pointer(&insns[0])),
+		License:   bpfAddr(unsafe.Pointer(lic)),
+	}
+	if len(log) > 0 {
+		attr.Log_level = 1
+		attr.Log_size = uint32(len(log))
+		attr.Log_buf = bpfBytes(log)
+	}
+	fd, err = Bpf(BPF_PROG_LOAD, unsafe.Pointer(&attr), SizeofBpfProgLoadAttr)
+	runtime.KeepAlive(insns)
+	runtime.KeepAlive(lic)
+	runtime.KeepAlive(log)
+	return fd, err
+}
+
+// BpfObjPin pins the map or program fd at path, which must be on a bpf
+// file system, so that it outlives the process.
+func BpfObjPin(fd int, path string) error {
+	p, err := BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	attr := BpfObjAttr{Pathname: bpfAddr(unsafe.Pointer(p)), Bpf_fd: uint32(fd)}
+	_, err = Bpf(BPF_OBJ_PIN, unsafe.Pointer(&attr), SizeofBpfObjAttr)
+	runtime.KeepAlive(p)
+	return err
+}
+
+// BpfObjGet opens the map or program pinned at path. flags is 0,
+// BPF_F_RDONLY or BPF_F_WRONLY.
+func BpfObjGet(path string, flags int) (fd int, err error) {
+	p, err := BytePtrFromString(path)
+	if err != nil {
+		return -1, err
+	}
+	attr := BpfObjAttr{Pathname: bpfAddr(unsafe.Pointer(p)), File_flags: uint32(flags)}
+	fd, err = Bpf(BPF_OBJ_GET, unsafe.Pointer(&attr), SizeofBpfObjAttr)
+	runtime.KeepAlive(p)
+	return fd, err
+}
+
+// BpfAttachSocket attaches the BPF_PROG_TYPE_SOCKET_FILTER program prog to
+// the socket fd with SO_ATTACH_BPF, replacing any filter attached before.
+func BpfAttachSocket(fd, prog int) error {
+	return SetsockoptInt(fd, SOL_SOCKET, SO_ATTACH_BPF, prog)
+}
+
+// BpfDetachSocket removes the filter attached to the socket fd.
+func BpfDetachSocket(fd int) error {
+	return SetsockoptInt(fd, SOL_SOCKET, SO_DETACH_BPF, 0)
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func newTestBpfMap(t *testing.T) int {
+	fd, err := unix.BpfMapCreate(&unix.BpfMapCreateAttr{
+		Map_type:    unix.BPF_MAP_TYPE_HASH,
+		Key_size:    4,
+		Value_size:  8,
+		Max_entries: 4,
+	})
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("bpf not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("BpfMapCreate: %v", err)
+	}
+	return fd
+}
+
+func TestBpfMap(t *testing.T) {
+	fd := newTestBpfMap(t)
+	defer unix.Close(fd)
+
+	key := make([]byte, 4)
+	value := make([]byte, 8)
+	for i := uint32(1); i <= 3; i++ {
+		binary.LittleEndian.PutUint32(key, i)
+		binary.LittleEndian.PutUint64(value, uint64(i)*10)
+		if err := unix.BpfMapUpdateElem(fd, key, value, unix.BPF_NOEXIST); err != nil {
+			t.Fatalf("BpfMapUpdateElem %d: %v", i, err)
+		}
+	}
+	if err := unix.BpfMapUpdateElem(fd, key, value, unix.BPF_NOEXIST); err != unix.EEXIST {
+		t.Errorf("BpfMapUpdateElem of an existing key with BPF_NOEXIST: %v, want EEXIST", err)
+	}
+
+	binary.LittleEndian.PutUint32(key, 2)
+	if err := unix.BpfMapLookupElem(fd, key, value); err != nil {
+		t.Fatalf("BpfMapLookupElem: %v", err)
+	}
+	if v := binary.LittleEndian.Uint64(value); v != 20 {
+		t.Errorf("value of key 2 = %d, want 20", v)
+	}
+	if err := unix.BpfMapDeleteElem(fd, key); err != nil {
+		t.Fatalf("BpfMapDeleteElem: %v", err)
+	}
+	if err := unix.BpfMapLookupElem(fd, key, value); err != unix.ENOENT {
+		t.Errorf("BpfMapLookupElem of a deleted key: %v, want ENOENT", err)
+	}
+
+	var keys []uint32
+	var cur []byte
+	next := make([]byte, 4)
+	for {
+		if err := unix.BpfMapGetNextKey(fd, cur, next); err == unix.ENOENT {
+			break
+		} else if err != nil {
+			t.Fatalf("BpfMapGetNextKey: %v", err)
+		}
+		keys = append(keys, binary.LittleEndian.Uint32(next))
+		cur = append(cur[:0], next...)
+	}
+	if len(keys) != 2 {
+		t.Errorf("iterated keys %v, want 1 and 3", keys)
+	}
+
+	info, err := unix.BpfMapGetInfo(fd)
+	if err != nil {
+		t.Fatalf("BpfMapGetInfo: %v", err)
+	}
+	if info.Type != unix.BPF_MAP_TYPE_HASH || info.Key_size != 4 || info.Value_size != 8 || info.Max_entries != 4 {
+		t.Errorf("BpfMapGetInfo = %+v, want a hash map with 4 byte keys, 8 byte values and 4 entries", info)
+	}
+	binary.LittleEndian.PutUint32(key, 1)
+	if err := unix.BpfMapLookupElem(fd, key, value[:7]); err != unix.EINVAL {
+		t.Errorf("BpfMapLookupElem with a short value: %v, want EINVAL", err)
+	}
+	if err := unix.BpfMapUpdateElem(fd, key[:3], value, unix.BPF_ANY); err != unix.EINVAL {
+		t.Errorf("BpfMapUpdateElem with a short key: %v, want EINVAL", err)
+	}
+	if err := unix.BpfMapGetNextKey(fd, nil, next[:3]); err != unix.EINVAL {
+		t.Errorf("BpfMapGetNextKey with a short next key: %v, want EINVAL", err)
+	}
+}
+
+func TestBpfMapPerCPU(t *testing.T) {
+	fd, err := unix.BpfMapCreate(&unix.BpfMapCreateAttr{
+		Map_type:    unix.BPF_MAP_TYPE_PERCPU_ARRAY,
+		Key_size:    4,
+		Value_size:  4,
+		Max_entries: 1,
+	})
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("bpf not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("BpfMapCreate: %v", err)
+	}
+	defer unix.Close(fd)
+
+	// Each CPU's value is padded to 8 bytes.
+	key := make([]byte, 4)
+	if err := unix.BpfMapLookupElem(fd, key, make([]byte, 8*runtime.NumCPU()-1)); err != unix.EINVAL {
+		t.Errorf("BpfMapLookupElem with a value for fewer CPUs: %v, want EINVAL", err)
+	}
+	if err := unix.BpfMapLookupElem(fd, key, make([]byte, 8*4096)); err != nil {
+		t.Errorf("BpfMapLookupElem: %v", err)
+	}
+}
+
+func TestBpfProgLoad(t *testing.T) {
+	// An invalid program: r0 is returned without having been set.
+	exit := unix.BpfInsn{Code: unix.BPF_JMP | unix.BPF_EXIT}
+	log := make([]byte, 4096)
+	_, err := unix.BpfProgLoad(unix.BPF_PROG_TYPE_SOCKET_FILTER, []unix.BpfInsn{exit}, "GPL", log)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("bpf not available: %v", err)
+	}
+	if err != unix.EACCES {
+		t.Fatalf("BpfProgLoad of an invalid program: %v, want EACCES", err)
+	}
+	if !bytes.Contains(log, []byte("R0")) {
+		t.Errorf("verifier log %q does not mention R0", log[:bytes.IndexByte(log, 0)])
+	}
+
+	// r0 = 0; exit: drop every packet.
+	mov := unix.BpfInsn{Code: unix.BPF_ALU64 | unix.BPF_MOV | unix.BPF_K}
+	mov.SetRegs(unix.BPF_REG_0, 0)
+	if mov.DstReg() != unix.BPF_REG_0 || mov.SrcReg() != 0 {
+		t.Errorf("registers of %+v = %d, %d", mov, mov.DstReg(), mov.SrcReg())
+	}
+	prog, err := unix.BpfProgLoad(unix.BPF_PROG_TYPE_SOCKET_FILTER, []unix.BpfInsn{mov, exit}, "GPL", nil)
+	if err != nil {
+		t.Fatalf("BpfProgLoad: %v", err)
+	}
+	defer unix.Close(prog)
+
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Socketpair: %v", err)
+	}
+	defer unix.Close(fds[0])
+	defer unix.Close(fds[1])
+	if err := unix.BpfAttachSocket(fds[1], prog); err != nil {
+		t.Fatalf("BpfAttachSocket: %v", err)
+	}
+	buf := make([]byte, 16)
+	if _, err := unix.Write(fds[0], []byte("dropped")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, _, err := unix.Recvfrom(fds[1], buf, unix.MSG_DONTWAIT); err != unix.EAGAIN {
+		t.Errorf("Recvfrom with a drop filter attached: %v, want EAGAIN", err)
+	}
+	if err := unix.BpfDetachSocket(fds[1]); err != nil {
+		t.Fatalf("BpfDetachSocket: %v", err)
+	}
+	if _, err := unix.Write(fds[0], []byte("passed")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if n, _, err := unix.Recvfrom(fds[1], buf, unix.MSG_DONTWAIT); err != nil || string(buf[:n]) != "passed" {
+		t.Errorf("Recvfrom after BpfDetachSocket: %q, %v", buf[:n], err)
+	}
+}
+
+func TestBpfObjPin(t *testing.T) {
+	fd := newTestBpfMap(t)
+	defer unix.Close(fd)
+
+	dir, err := ioutil.TempDir("", "TestBpfObjPin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := unix.Mount("bpf", dir, "bpf", 0, ""); err != nil {
+		t.Skipf("cannot mount a bpf file system: %v", err)
+	}
+	defer unix.Unmount(dir, unix.MNT_DETACH)
+
+	path := filepath.Join(dir, "map")
+	if err := unix.BpfObjPin(fd, path); err != nil {
+		t.Fatalf("BpfObjPin: %v", err)
+	}
+	key, value := []byte{1, 0, 0, 0}, []byte{7, 0, 0, 0, 0, 0, 0, 0}
+	if err := unix.BpfMapUpdateElem(fd, key, value, unix.BPF_ANY); err != nil {
+		t.Fatalf("BpfMapUpdateElem: %v", err)
+	}
+
+	fd2, err := unix.BpfObjGet(path, unix.BPF_F_RDONLY)
+	if err != nil {
+		t.Fatalf("BpfObjGet: %v", err)
+	}
+	defer unix.Close(fd2)
+	got := make([]byte, 8)
+	if err := unix.BpfMapLookupElem(fd2, key, got); err != nil || !bytes.Equal(got, value) {
+		t.Errorf("BpfMapLookupElem through the pinned map: %v, %v", got, err)
+	}
+	if err := unix.BpfMapUpdateElem(fd2, key, value, unix.BPF_ANY); err != unix.EPERM {
+		t.Errorf("BpfMapUpdateElem on a read-only map: %v, want EPERM", err)
+	}
+}
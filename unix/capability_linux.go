@@ -0,0 +1,334 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Process and file capabilities
+
+package unix
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// Capability is a capability number, one of the CAP_* values.
+type Capability int
+
+var capabilityNames = [...]string{
+	CAP_CHOWN:              "CAP_CHOWN",
+	CAP_DAC_OVERRIDE:       "CAP_DAC_OVERRIDE",
+	CAP_DAC_READ_SEARCH:    "CAP_DAC_READ_SEARCH",
+	CAP_FOWNER:             "CAP_FOWNER",
+	CAP_FSETID:             "CAP_FSETID",
+	CAP_KILL:               "CAP_KILL",
+	CAP_SETGID:             "CAP_SETGID",
+	CAP_SETUID:             "CAP_SETUID",
+	CAP_SETPCAP:            "CAP_SETPCAP",
+	CAP_LINUX_IMMUTABLE:    "CAP_LINUX_IMMUTABLE",
+	CAP_NET_BIND_SERVICE:   "CAP_NET_BIND_SERVICE",
+	CAP_NET_BROADCAST:      "CAP_NET_BROADCAST",
+	CAP_NET_ADMIN:          "CAP_NET_ADMIN",
+	CAP_NET_RAW:            "CAP_NET_RAW",
+	CAP_IPC_LOCK:           "CAP_IPC_LOCK",
+	CAP_IPC_OWNER:          "CAP_IPC_OWNER",
+	CAP_SYS_MODULE:         "CAP_SYS_MODULE",
+	CAP_SYS_RAWIO:          "CAP_SYS_RAWIO",
+	CAP_SYS_CHROOT:         "CAP_SYS_CHROOT",
+	CAP_SYS_PTRACE:         "CAP_SYS_PTRACE",
+	CAP_SYS_PACCT:          "CAP_SYS_PACCT",
+	CAP_SYS_ADMIN:          "CAP_SYS_ADMIN",
+	CAP_SYS_BOOT:           "CAP_SYS_BOOT",
+	CAP_SYS_NICE:           "CAP_SYS_NICE",
+	CAP_SYS_RESOURCE:       "CAP_SYS_RESOURCE",
+	CAP_SYS_TIME:           "CAP_SYS_TIME",
+	CAP_SYS_TTY_CONFIG:     "CAP_SYS_TTY_CONFIG",
+	CAP_MKNOD:              "CAP_MKNOD",
+	CAP_LEASE:              "CAP_LEASE",
+	CAP_AUDIT_WRITE:        "CAP_AUDIT_WRITE",
+	CAP_AUDIT_CONTROL:      "CAP_AUDIT_CONTROL",
+	CAP_SETFCAP:            "CAP_SETFCAP",
+	CAP_MAC_OVERRIDE:       "CAP_MAC_OVERRIDE",
+	CAP_MAC_ADMIN:          "CAP_MAC_ADMIN",
+	CAP_SYSLOG:             "CAP_SYSLOG",
+	CAP_WAKE_ALARM:         "CAP_WAKE_ALARM",
+	CAP_BLOCK_SUSPEND:      "CAP_BLOCK_SUSPEND",
+	CAP_AUDIT_READ:         "CAP_AUDIT_READ",
+	CAP_PERFMON:            "CAP_PERFMON",
+	CAP_BPF:                "CAP_BPF",
+	CAP_CHECKPOINT_RESTORE: "CAP_CHECKPOINT_RESTORE",
+}
+
+// String returns the name of c, such as "CAP_SYS_ADMIN", or "CAP_"
+// followed by its number if it is not known.
+func (c Capability) String() string {
+	if c >= 0 && int(c) < len(capabilityNames) {
+		return capabilityNames[c]
+	}
+	return "CAP_" + itoa(int(c))
+}
+
+// ParseCapability returns the capability with the given name, such as
+// "CAP_SYS_ADMIN", ignoring case so that the lower-case names of libcap
+// are accepted too. It returns EINVAL if the name is not known.
+func ParseCapability(name string) (Capability, error) {
+	for c, n := range capabilityNames {
+		if strings.EqualFold(name, n) {
+			return Capability(c), nil
+		}
+	}
+	return 0, EINVAL
+}
+
+// CapabilitySet is a set of capabilities, with capability n in bit n.
+type CapabilitySet uint64
+
+// Add adds c to the set. Invalid capability numbers are ignored.
+func (s *CapabilitySet) Add(c Capability) {
+	if c >= 0 && c < 64 {
+		*s |= 1 << uint(c)
+	}
+}
+
+// Del removes c from the set. Invalid capability numbers are ignored.
+func (s *CapabilitySet) Del(c Capability) {
+	if c >= 0 && c < 64 {
+		*s &^= 1 << uint(c)
+	}
+}
+
+// IsMember reports whether c is in the set.
+func (s CapabilitySet) IsMember(c Capability) bool {
+	return c >= 0 && c < 64 && s&(1<<uint(c)) != 0
+}
+
+// String returns the names of the capabilities in s separated by "|",
+// for example "CAP_CHOWN|CAP_KILL", or "0" if s is empty.
+func (s CapabilitySet) String() string {
+	if s == 0 {
+		return "0"
+	}
+	var names []string
+	for c := Capability(0); c < 64; c++ {
+		if s.IsMember(c) {
+			names = append(names, c.String())
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// Capabilities holds the effective, permitted and inheritable capability
+// sets of a thread.
+type Capabilities struct {
+	Effective   CapabilitySet // capabilities used for permission checks
+	Permitted   CapabilitySet // limit of the effective and inheritable sets
+	Inheritable CapabilitySet // capabilities preserved across execve
+}
+
+// GetCapabilities returns the capabilities of the thread tid, or of the
+// calling thread if tid is 0, with Capget. The ID of the main thread of a
+// process is its PID.
+func GetCapabilities(tid int) (*Capabilities, error) {
+	hdr := CapUserHeader{Version: LINUX_CAPABILITY_VERSION_3, Pid: int32(tid)}
+	var data [LINUX_CAPABILITY_U32S_3]CapUserData
+	if err := Capget(&hdr, &data[0]); err != nil {
+		return nil, err
+	}
+	return &Capabilities{
+		Effective:   CapabilitySet(data[0].Effective) | CapabilitySet(data[1].Effective)<<32,
+		Permitted:   CapabilitySet(data[0].Permitted) | CapabilitySet(data[1].Permitted)<<32,
+		Inheritable: CapabilitySet(data[0].Inheritable) | CapabilitySet(data[1].Inheritable)<<32,
+	}, nil
+}
+
+// SetCapabilities sets the capabilities of the calling thread with Capset.
+// Capabilities can be removed from the permitted set but not added back,
+// and the effective and inheritable sets must be subsets of it, except
+// that CAP_SETPCAP allows adding capabilities of the bounding set to the
+// inheritable set.
+//
+// Capabilities belong to a thread, and the Go runtime runs goroutines on
+// many threads, so the calling goroutine should be locked to its thread
+// with runtime.LockOSThread, for example to drop capabilities before
+// calling Exec. A thread whose capabilities have been dropped should not
+// be unlocked, so that it exits with the goroutine instead of running
+// others.
+func SetCapabilities(caps *Capabilities) error {
+	hdr := CapUserHeader{Version: LINUX_CAPABILITY_VERSION_3}
+	data := [LINUX_CAPABILITY_U32S_3]CapUserData{
+		{
+			Effective:   uint32(caps.Effective),
+			Permitted:   uint32(caps.Permitted),
+			Inheritable: uint32(caps.Inheritable),
+		},
+		{
+			Effective:   uint32(caps.Effective >> 32),
+			Permitted:   uint32(caps.Permitted >> 32),
+			Inheritable: uint32(caps.Inheritable >> 32),
+		},
+	}
+	return Capset(&hdr, &data[0])
+}
+
+// capProbe returns the set of capabilities for which isSet returns 1. It
+// stops at the first capability the kernel does not know, for which prctl
+// fails with EINVAL.
+func capProbe(isSet func(c Capability) (int, error)) (CapabilitySet, error) {
+	var s CapabilitySet
+	for c := Capability(0); c < 64; c++ {
+		ret, err := isSet(c)
+		if err == EINVAL {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if ret == 1 {
+			s.Add(c)
+		}
+	}
+	return s, nil
+}
+
+// CapBoundingSet returns the capability bounding set of the calling
+// thread, which limits the capabilities it can gain on execve.
+func CapBoundingSet() (CapabilitySet, error) {
+	return capProbe(func(c Capability) (int, error) {
+		return prctlRet(PR_CAPBSET_READ, uintptr(c), 0, 0, 0)
+	})
+}
+
+// CapBoundingSetDrop removes c from the capability bounding set of the
+// calling thread, which requires the CAP_SETPCAP capability and cannot be
+// undone. See SetCapabilities about threads.
+func CapBoundingSetDrop(c Capability) error {
+	return Prctl(PR_CAPBSET_DROP, uintptr(c), 0, 0, 0)
+}
+
+// CapAmbientSet returns the ambient capability set of the calling thread,
+// whose capabilities are preserved across execve of programs without file
+// capabilities.
+func CapAmbientSet() (CapabilitySet, error) {
+	return capProbe(func(c Capability) (int, error) {
+		return prctlRet(PR_CAP_AMBIENT, PR_CAP_AMBIENT_IS_SET, uintptr(c), 0, 0)
+	})
+}
+
+// CapAmbientRaise adds c to the ambient capability set of the calling
+// thread. c must be in both its permitted and inheritable sets.
+func CapAmbientRaise(c Capability) error {
+	return Prctl(PR_CAP_AMBIENT, PR_CAP_AMBIENT_RAISE, uintptr(c), 0, 0)
+}
+
+// CapAmbientLower removes c from the ambient capability set of the
+// calling thread.
+func CapAmbientLower(c Capability) error {
+	return Prctl(PR_CAP_AMBIENT, PR_CAP_AMBIENT_LOWER, uintptr(c), 0, 0)
+}
+
+// CapAmbientClearAll empties the ambient capability set of the calling
+// thread.
+func CapAmbientClearAll() error {
+	return Prctl(PR_CAP_AMBIENT, PR_CAP_AMBIENT_CLEAR_ALL, 0, 0, 0)
+}
+
+// XATTR_NAME_CAPS is the name of the extended attribute that holds the
+// capabilities of a file, in the format of FileCapabilities.MarshalBinary.
+const XATTR_NAME_CAPS = "security.capability"
+
+// FileCapabilities are the capabilities of an executable file, which a
+// thread gains when it executes the file.
+type FileCapabilities struct {
+	Effective   bool          // whether the new permitted set is also made effective, VFS_CAP_FLAGS_EFFECTIVE
+	Permitted   CapabilitySet // capabilities added to the permitted set, within the bounding set
+	Inheritable CapabilitySet // capabilities kept from the inheritable set of the thread
+	RootID      uint32        // for VFS_CAP_REVISION_3, user ID of root in the user namespace the capabilities apply to
+	Revision    uint32        // VFS_CAP_REVISION_1, VFS_CAP_REVISION_2, VFS_CAP_REVISION_3, or 0 to choose one from RootID
+}
+
+// MarshalBinary encodes fc in the format of the security.capability
+// extended attribute, which can be set with Setxattr and XATTR_NAME_CAPS
+// by a thread with CAP_SETFCAP. It uses the format fc.Revision or, if that
+// is 0, VFS_CAP_REVISION_3 if fc.RootID is not 0 and VFS_CAP_REVISION_2
+// otherwise, so that a value decoded by UnmarshalBinary is encoded in its
+// original format. It returns EINVAL if fc does not fit the format.
+func (fc *FileCapabilities) MarshalBinary() ([]byte, error) {
+	rev := fc.Revision
+	if rev == 0 {
+		rev = VFS_CAP_REVISION_2
+		if fc.RootID != 0 {
+			rev = VFS_CAP_REVISION_3
+		}
+	}
+	var size int
+	switch rev {
+	case VFS_CAP_REVISION_1:
+		if fc.RootID != 0 || (fc.Permitted|fc.Inheritable)>>32 != 0 {
+			return nil, EINVAL
+		}
+		size = XATTR_CAPS_SZ_1
+	case VFS_CAP_REVISION_2:
+		if fc.RootID != 0 {
+			return nil, EINVAL
+		}
+		size = XATTR_CAPS_SZ_2
+	case VFS_CAP_REVISION_3:
+		size = XATTR_CAPS_SZ_3
+	default:
+		return nil, EINVAL
+	}
+	magic := rev
+	if fc.Effective {
+		magic |= VFS_CAP_FLAGS_EFFECTIVE
+	}
+	b := make([]byte, size)
+	binary.LittleEndian.PutUint32(b[0:], magic)
+	binary.LittleEndian.PutUint32(b[4:], uint32(fc.Permitted))
+	binary.LittleEndian.PutUint32(b[8:], uint32(fc.Inheritable))
+	if size >= XATTR_CAPS_SZ_2 {
+		binary.LittleEndian.PutUint32(b[12:], uint32(fc.Permitted>>32))
+		binary.LittleEndian.PutUint32(b[16:], uint32(fc.Inheritable>>32))
+	}
+	if size == XATTR_CAPS_SZ_3 {
+		binary.LittleEndian.PutUint32(b[20:], fc.RootID)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes the value of a security.capability extended
+// attribute, as returned by Getxattr, in any of the VFS_CAP_REVISION_1,
+// VFS_CAP_REVISION_2 and VFS_CAP_REVISION_3 formats, and records the
+// format in fc.Revision. It returns EINVAL if data is not in one of them.
+func (fc *FileCapabilities) UnmarshalBinary(data []byte) error {
+	if len(data) < 4 {
+		return EINVAL
+	}
+	magic := binary.LittleEndian.Uint32(data)
+	var want int
+	switch magic & VFS_CAP_REVISION_MASK {
+	case VFS_CAP_REVISION_1:
+		want = XATTR_CAPS_SZ_1
+	case VFS_CAP_REVISION_2:
+		want = XATTR_CAPS_SZ_2
+	case VFS_CAP_REVISION_3:
+		want = XATTR_CAPS_SZ_3
+	default:
+		return EINVAL
+	}
+	if len(data) != want {
+		return EINVAL
+	}
+	*fc = FileCapabilities{
+		Effective:   magic&VFS_CAP_FLAGS_EFFECTIVE != 0,
+		Permitted:   CapabilitySet(binary.LittleEndian.Uint32(data[4:])),
+		Inheritable: CapabilitySet(binary.LittleEndian.Uint32(data[8:])),
+		Revision:    magic & VFS_CAP_REVISION_MASK,
+	}
+	if want >= XATTR_CAPS_SZ_2 {
+		fc.Permitted |= CapabilitySet(binary.LittleEndian.Uint32(data[12:])) << 32
+		fc.Inheritable |= CapabilitySet(binary.LittleEndian.Uint32(data[16:])) << 32
+	}
+	if want == XATTR_CAPS_SZ_3 {
+		fc.RootID = binary.LittleEndian.Uint32(data[20:])
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// threadCapSets returns the capability sets of the calling thread from
+// /proc, keyed by their names in the status file such as "CapEff".
+func threadCapSets(t *testing.T) map[string]unix.CapabilitySet {
+	b, err := ioutil.ReadFile(fmt.Sprintf("/proc/self/task/%d/status", unix.Gettid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sets := make(map[string]unix.CapabilitySet)
+	for _, line := range strings.Split(string(b), "\n") {
+		f := strings.Fields(line)
+		if len(f) != 2 || !strings.HasPrefix(f[0], "Cap") {
+			continue
+		}
+		v, err := strconv.ParseUint(f[1], 16, 64)
+		if err != nil {
+			t.Fatalf("parsing %q: %v", line, err)
+		}
+		sets[strings.TrimSuffix(f[0], ":")] = unix.CapabilitySet(v)
+	}
+	return sets
+}
+
+func TestCapabilityNames(t *testing.T) {
+	for _, tt := range []struct {
+		c    unix.Capability
+		name string
+	}{
+		{unix.CAP_CHOWN, "CAP_CHOWN"},
+		{unix.CAP_SYS_ADMIN, "CAP_SYS_ADMIN"},
+		{unix.CAP_LAST_CAP, "CAP_CHECKPOINT_RESTORE"},
+	} {
+		if got := tt.c.String(); got != tt.name {
+			t.Errorf("Capability(%d).String() = %q, want %q", tt.c, got, tt.name)
+		}
+		for _, name := range []string{tt.name, strings.ToLower(tt.name)} {
+			if c, err := unix.ParseCapability(name); err != nil || c != tt.c {
+				t.Errorf("ParseCapability(%q) = %d, %v; want %d", name, c, err, tt.c)
+			}
+		}
+	}
+	if got := unix.Capability(63).String(); got != "CAP_63" {
+		t.Errorf("Capability(63).String() = %q, want CAP_63", got)
+	}
+	if _, err := unix.ParseCapability("CAP_NONE"); err != unix.EINVAL {
+		t.Errorf("ParseCapability(CAP_NONE) = %v, want EINVAL", err)
+	}
+
+	var s unix.CapabilitySet
+	s.Add(unix.CAP_KILL)
+	s.Add(unix.CAP_CHOWN)
+	s.Add(unix.CAP_BPF)
+	s.Add(64)
+	s.Del(unix.CAP_BPF)
+	if got, want := s.String(), "CAP_CHOWN|CAP_KILL"; got != want {
+		t.Errorf("CapabilitySet.String() = %q, want %q", got, want)
+	}
+	if !s.IsMember(unix.CAP_KILL) || s.IsMember(unix.CAP_BPF) || s.IsMember(64) {
+		t.Errorf("IsMember is wrong for %v", s)
+	}
+}
+
+func TestGetCapabilities(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	caps, err := unix.GetCapabilities(0)
+	if err != nil {
+		t.Fatalf("GetCapabilities: %v", err)
+	}
+	want := threadCapSets(t)
+	if caps.Effective != want["CapEff"] || caps.Permitted != want["CapPrm"] || caps.Inheritable != want["CapInh"] {
+		t.Errorf("GetCapabilities = %+v, want CapEff %v, CapPrm %v, CapInh %v", *caps, want["CapEff"], want["CapPrm"], want["CapInh"])
+	}
+	if other, err := unix.GetCapabilities(os.Getpid()); err != nil {
+		t.Errorf("GetCapabilities(pid): %v", err)
+	} else if os.Getpid() == unix.Gettid() && *other != *caps {
+		t.Errorf("GetCapabilities(pid) = %+v, want %+v", *other, *caps)
+	}
+
+	bnd, err := unix.CapBoundingSet()
+	if err != nil {
+		t.Fatalf("CapBoundingSet: %v", err)
+	}
+	if bnd != want["CapBnd"] {
+		t.Errorf("CapBoundingSet = %v, want %v", bnd, want["CapBnd"])
+	}
+	if amb, ok := want["CapAmb"]; ok {
+		got, err := unix.CapAmbientSet()
+		if err != nil {
+			t.Fatalf("CapAmbientSet: %v", err)
+		}
+		if got != amb {
+			t.Errorf("CapAmbientSet = %v, want %v", got, amb)
+		}
+	}
+}
+
+func TestSetCapabilities(t *testing.T) {
+	caps, err := unix.GetCapabilities(0)
+	if err != nil {
+		t.Fatalf("GetCapabilities: %v", err)
+	}
+	if !caps.Permitted.IsMember(unix.CAP_NET_RAW) || !caps.Permitted.IsMember(unix.CAP_SETPCAP) {
+		t.Skip("test requires the CAP_NET_RAW and CAP_SETPCAP capabilities")
+	}
+
+	// The changes are made on a thread that exits with the goroutine,
+	// since they cannot all be undone.
+	errc := make(chan error, 1)
+	go func() {
+		runtime.LockOSThread()
+		errc <- func() error {
+			c := *caps
+			c.Effective.Del(unix.CAP_NET_RAW)
+			c.Inheritable.Add(unix.CAP_NET_RAW)
+			if err := unix.SetCapabilities(&c); err != nil {
+				return fmt.Errorf("SetCapabilities: %v", err)
+			}
+			got, err := unix.GetCapabilities(0)
+			if err != nil {
+				return err
+			}
+			if *got != c {
+				return fmt.Errorf("GetCapabilities after SetCapabilities = %+v, want %+v", *got, c)
+			}
+
+			if err := unix.CapAmbientRaise(unix.CAP_NET_RAW); err != nil {
+				return fmt.Errorf("CapAmbientRaise: %v", err)
+			}
+			if amb, err := unix.CapAmbientSet(); err != nil || !amb.IsMember(unix.CAP_NET_RAW) {
+				return fmt.Errorf("CapAmbientSet after raising CAP_NET_RAW = %v, %v", amb, err)
+			}
+			if err := unix.CapAmbientLower(unix.CAP_NET_RAW); err != nil {
+				return fmt.Errorf("CapAmbientLower: %v", err)
+			}
+			if err := unix.CapAmbientRaise(unix.CAP_NET_RAW); err != nil {
+				return fmt.Errorf("CapAmbientRaise: %v", err)
+			}
+			if err := unix.CapAmbientClearAll(); err != nil {
+				return fmt.Errorf("CapAmbientClearAll: %v", err)
+			}
+			if amb, err := unix.CapAmbientSet(); err != nil || amb != 0 {
+				return fmt.Errorf("CapAmbientSet after clearing = %v, %v", amb, err)
+			}
+
+			if err := unix.CapBoundingSetDrop(unix.CAP_NET_RAW); err != nil {
+				return fmt.Errorf("CapBoundingSetDrop: %v", err)
+			}
+			if bnd, err := unix.CapBoundingSet(); err != nil || bnd.IsMember(unix.CAP_NET_RAW) {
+				return fmt.Errorf("CapBoundingSet after dropping CAP_NET_RAW = %v, %v", bnd, err)
+			}
+			return nil
+		}()
+	}()
+	if err := <-errc; err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFileCapabilities(t *testing.T) {
+	for _, tt := range []struct {
+		fc  unix.FileCapabilities
+		rev uint32
+	}{
+		{unix.FileCapabilities{Effective: true, Permitted: 1<<unix.CAP_NET_BIND_SERVICE | 1<<unix.CAP_BPF}, unix.VFS_CAP_REVISION_2},
+		{unix.FileCapabilities{Inheritable: 1 << unix.CAP_KILL, RootID: 100000}, unix.VFS_CAP_REVISION_3},
+		{unix.FileCapabilities{Permitted: 1 << unix.CAP_KILL, Revision: unix.VFS_CAP_REVISION_3}, unix.VFS_CAP_REVISION_3},
+		{unix.FileCapabilities{Effective: true, Permitted: 1 << unix.CAP_KILL, Revision: unix.VFS_CAP_REVISION_1}, unix.VFS_CAP_REVISION_1},
+	} {
+		b, err := tt.fc.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		magic := binary.LittleEndian.Uint32(b)
+		if magic&unix.VFS_CAP_REVISION_MASK != tt.rev || (magic&unix.VFS_CAP_FLAGS_EFFECTIVE != 0) != tt.fc.Effective {
+			t.Errorf("MarshalBinary(%+v) has the magic %#x", tt.fc, magic)
+		}
+		want := tt.fc
+		want.Revision = tt.rev
+		var got unix.FileCapabilities
+		if err := got.UnmarshalBinary(b); err != nil || got != want {
+			t.Errorf("UnmarshalBinary(MarshalBinary(%+v)) = %+v, %v; want %+v", tt.fc, got, err, want)
+		}
+		if again, err := got.MarshalBinary(); err != nil || !bytes.Equal(again, b) {
+			t.Errorf("MarshalBinary(%+v) = %x, %v; want %x", got, again, err, b)
+		}
+		if err := got.UnmarshalBinary(b[:len(b)-1]); err != unix.EINVAL {
+			t.Errorf("UnmarshalBinary of a truncated value = %v, want EINVAL", err)
+		}
+	}
+
+	for _, fc := range []unix.FileCapabilities{
+		{Permitted: 1 << unix.CAP_BPF, Revision: unix.VFS_CAP_REVISION_1},
+		{RootID: 100000, Revision: unix.VFS_CAP_REVISION_2},
+		{Revision: 9},
+	} {
+		if _, err := fc.MarshalBinary(); err != unix.EINVAL {
+			t.Errorf("MarshalBinary(%+v) = %v, want EINVAL", fc, err)
+		}
+	}
+
+	// A VFS_CAP_REVISION_1 value with 32-bit sets.
+	var got unix.FileCapabilities
+	v1 := []byte{0x01, 0x00, 0x00, 0x01, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	want := unix.FileCapabilities{Effective: true, Permitted: 1 << unix.CAP_KILL, Revision: unix.VFS_CAP_REVISION_1}
+	if err := got.UnmarshalBinary(v1); err != nil || got != want {
+		t.Errorf("UnmarshalBinary(v1) = %+v, %v; want %+v", got, err, want)
+	}
+	if err := got.UnmarshalBinary([]byte{0, 0, 0, 9}); err != unix.EINVAL {
+		t.Errorf("UnmarshalBinary of an unknown revision = %v, want EINVAL", err)
+	}
+}
+
+func TestFileCapabilitiesXattr(t *testing.T) {
+	caps, err := unix.GetCapabilities(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !caps.Effective.IsMember(unix.CAP_SETFCAP) {
+		t.Skip("setting file capabilities requires the CAP_SETFCAP capability")
+	}
+	dir, err := ioutil.TempDir("", "filecaps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "prog")
+	if err := ioutil.WriteFile(path, nil, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	fc := unix.FileCapabilities{Effective: true, Permitted: 1 << unix.CAP_NET_BIND_SERVICE}
+	b, err := fc.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.Setxattr(path, unix.XATTR_NAME_CAPS, b, 0); err != nil {
+		if err == unix.EOPNOTSUPP {
+			t.Skipf("file system does not support file capabilities: %v", err)
+		}
+		t.Fatalf("Setxattr: %v", err)
+	}
+	buf := make([]byte, unix.XATTR_CAPS_SZ)
+	n, err := unix.Getxattr(path, unix.XATTR_NAME_CAPS, buf)
+	if err != nil {
+		t.Fatalf("Getxattr: %v", err)
+	}
+	if !bytes.Equal(buf[:n], b) {
+		t.Errorf("Getxattr = %x, want %x", buf[:n], b)
+	}
+	var got unix.FileCapabilities
+	fc.Revision = unix.VFS_CAP_REVISION_2
+	if err := got.UnmarshalBinary(buf[:n]); err != nil || got != fc {
+		t.Errorf("UnmarshalBinary = %+v, %v; want %+v", got, err, fc)
+	}
+}
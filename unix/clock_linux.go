@@ -0,0 +1,80 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Dynamic POSIX clocks and clock status
+
+package unix
+
+import (
+	"strconv"
+	"strings"
+)
+
+// clockfd is the type of clock IDs that refer to dynamic clocks.
+const clockfd = 3
+
+// FdToClockID returns the clock ID of the dynamic POSIX clock open as fd,
+// such as a PTP hardware clock /dev/ptpN, for use with ClockGettime,
+// ClockSettime, ClockAdjtime and the other clock functions. It is
+// equivalent to the FD_TO_CLOCKID macro.
+func FdToClockID(fd int) int32 {
+	return int32(^fd<<3 | clockfd)
+}
+
+// ClockIDToFd returns the file descriptor a dynamic clock ID returned by
+// FdToClockID refers to. It is equivalent to the CLOCKID_TO_FD macro.
+func ClockIDToFd(clockid int32) int {
+	return int(^(clockid >> 3))
+}
+
+// TimexStatus is the set of STA_* bits in the Status field of a Timex.
+type TimexStatus int32
+
+var timexStatusNames = []struct {
+	bit  TimexStatus
+	name string
+}{
+	{STA_PLL, "STA_PLL"},
+	{STA_PPSFREQ, "STA_PPSFREQ"},
+	{STA_PPSTIME, "STA_PPSTIME"},
+	{STA_FLL, "STA_FLL"},
+	{STA_INS, "STA_INS"},
+	{STA_DEL, "STA_DEL"},
+	{STA_UNSYNC, "STA_UNSYNC"},
+	{STA_FREQHOLD, "STA_FREQHOLD"},
+	{STA_PPSSIGNAL, "STA_PPSSIGNAL"},
+	{STA_PPSJITTER, "STA_PPSJITTER"},
+	{STA_PPSWANDER, "STA_PPSWANDER"},
+	{STA_PPSERROR, "STA_PPSERROR"},
+	{STA_CLOCKERR, "STA_CLOCKERR"},
+	{STA_NANO, "STA_NANO"},
+	{STA_MODE, "STA_MODE"},
+	{STA_CLK, "STA_CLK"},
+}
+
+// ReadOnly returns the bits of s that are set by the kernel and ignored
+// when changing the status with ADJ_STATUS.
+func (s TimexStatus) ReadOnly() TimexStatus {
+	return s & STA_RONLY
+}
+
+// String returns the names of the bits in s separated by "|", followed by
+// the remaining bits in hexadecimal if there are any, for example
+// "STA_PLL|STA_NANO".
+func (s TimexStatus) String() string {
+	if s == 0 {
+		return "0"
+	}
+	var names []string
+	for _, n := range timexStatusNames {
+		if s&n.bit != 0 {
+			names = append(names, n.name)
+			s &^= n.bit
+		}
+	}
+	if s != 0 {
+		names = append(names, "0x"+strconv.FormatUint(uint64(uint32(s)), 16))
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestClockNanosleep(t *testing.T) {
+	delay := 50 * time.Millisecond
+
+	// Relative sleep.
+	start := time.Now()
+	ts := unix.NsecToTimespec(int64(delay))
+	if err := unix.ClockNanosleep(unix.CLOCK_MONOTONIC, 0, &ts, nil); err != nil {
+		t.Fatalf("ClockNanosleep: %v", err)
+	}
+	if slept := time.Since(start); slept < delay {
+		t.Errorf("ClockNanosleep slept %v, want at least %v", slept, delay)
+	}
+
+	// Absolute sleep.
+	var now unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &now); err != nil {
+		t.Fatal(err)
+	}
+	deadline := unix.NsecToTimespec(now.Nano() + int64(delay))
+	if err := unix.ClockNanosleep(unix.CLOCK_MONOTONIC, unix.TIMER_ABSTIME, &deadline, nil); err != nil {
+		t.Fatalf("ClockNanosleep with TIMER_ABSTIME: %v", err)
+	}
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &now); err != nil {
+		t.Fatal(err)
+	}
+	if now.Nano() < deadline.Nano() {
+		t.Errorf("ClockNanosleep returned at %v, before the deadline %v", now.Nano(), deadline.Nano())
+	}
+}
+
+func TestClockSettime(t *testing.T) {
+	var now unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &now); err != nil {
+		t.Fatal(err)
+	}
+	// CLOCK_MONOTONIC cannot be set.
+	if err := unix.ClockSettime(unix.CLOCK_MONOTONIC, &now); err != unix.EINVAL {
+		t.Errorf("ClockSettime(CLOCK_MONOTONIC) = %v, want EINVAL", err)
+	}
+}
+
+func TestClockAdjtime(t *testing.T) {
+	var tx, want unix.Timex
+	state, err := unix.ClockAdjtime(unix.CLOCK_REALTIME, &tx)
+	if err != nil {
+		t.Fatalf("ClockAdjtime: %v", err)
+	}
+	if state < unix.TIME_OK || state > unix.TIME_ERROR {
+		t.Errorf("ClockAdjtime returned the state %d", state)
+	}
+	if _, err := unix.Adjtimex(&want); err != nil {
+		t.Fatalf("Adjtimex: %v", err)
+	}
+	if tx.Status != want.Status || tx.Tick != want.Tick {
+		t.Errorf("ClockAdjtime(CLOCK_REALTIME) status %v, tick %d; Adjtimex status %v, tick %d",
+			unix.TimexStatus(tx.Status), tx.Tick, unix.TimexStatus(want.Status), want.Tick)
+	}
+	t.Logf("state %d, status %v", state, unix.TimexStatus(tx.Status))
+
+	// Clocks other than CLOCK_REALTIME cannot be adjusted.
+	if _, err := unix.ClockAdjtime(unix.CLOCK_MONOTONIC, &tx); err != unix.EOPNOTSUPP && err != unix.EINVAL {
+		t.Errorf("ClockAdjtime(CLOCK_MONOTONIC) = %v, want EOPNOTSUPP", err)
+	}
+}
+
+func TestDynamicClockID(t *testing.T) {
+	for _, fd := range []int{0, 3, 1000, 1<<20 - 1} {
+		id := unix.FdToClockID(fd)
+		if id >= 0 {
+			t.Errorf("FdToClockID(%d) = %d, want a negative clock ID", fd, id)
+		}
+		if got := unix.ClockIDToFd(id); got != fd {
+			t.Errorf("ClockIDToFd(FdToClockID(%d)) = %d", fd, got)
+		}
+	}
+	if id := unix.FdToClockID(3); id != -29 {
+		t.Errorf("FdToClockID(3) = %d, want -29", id)
+	}
+
+	// A file that is not a clock.
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.FdToClockID(p[0]), &ts); err != unix.EINVAL {
+		t.Errorf("ClockGettime on a pipe = %v, want EINVAL", err)
+	}
+}
+
+func TestTimexStatusString(t *testing.T) {
+	for _, tt := range []struct {
+		s    unix.TimexStatus
+		want string
+	}{
+		{0, "0"},
+		{unix.STA_UNSYNC, "STA_UNSYNC"},
+		{unix.STA_PLL | unix.STA_NANO, "STA_PLL|STA_NANO"},
+		{unix.STA_INS | 0x10000, "STA_INS|0x10000"},
+	} {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("TimexStatus(%#x).String() = %q, want %q", int32(tt.s), got, tt.want)
+		}
+	}
+	if ro := unix.TimexStatus(unix.STA_PLL | unix.STA_NANO | unix.STA_CLOCKERR).ReadOnly(); ro != unix.STA_NANO|unix.STA_CLOCKERR {
+		t.Errorf("ReadOnly() = %v, want STA_CLOCKERR|STA_NANO", ro)
+	}
+}
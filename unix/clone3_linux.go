@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build go1.22
+
+// Process creation with clone3
+
+package unix
+
+import "syscall"
+
+// clone3ExecFlags are the clone flags Clone3Exec supports.
+const clone3ExecFlags = CLONE_NEWCGROUP | CLONE_NEWIPC | CLONE_NEWNET | CLONE_NEWNS |
+	CLONE_NEWPID | CLONE_NEWUSER | CLONE_NEWUTS | CLONE_PIDFD | CLONE_INTO_CGROUP
+
+// Clone3Attr holds the attributes of a child created by Clone3Exec.
+type Clone3Attr struct {
+	Flags  uint64 // CLONE_NEW* flags, CLONE_PIDFD and CLONE_INTO_CGROUP
+	Cgroup int    // cgroup directory descriptor for CLONE_INTO_CGROUP
+}
+
+// Clone3Exec creates a child process as described by attr and executes
+// argv0 in it with the arguments argv and the environment envv, as Exec
+// does. The child inherits the descriptors of the caller that are not
+// close-on-exec. It returns the PID of the child once the exec succeeded
+// and, if attr.Flags contains CLONE_PIDFD, a pidfd referring to it;
+// otherwise pidfd is -1.
+//
+// The child can be placed in new namespaces with the CLONE_NEW* flags and
+// in the cgroup attr.Cgroup with CLONE_INTO_CGROUP, which uses clone3.
+// Other flags are rejected with EINVAL. The child sends SIGCHLD to the
+// parent when it exits.
+//
+// Clone3Exec creates the child with syscall.ForkExec, which keeps the Go
+// runtime in a consistent state across the fork and holds
+// syscall.ForkLock, so that descriptors being opened by other goroutines
+// do not leak into the child. CloneArgs describes the arguments of the
+// clone3 system call itself.
+func Clone3Exec(attr *Clone3Attr, argv0 string, argv []string, envv []string) (pid int, pidfd int, err error) {
+	if attr.Flags&^clone3ExecFlags != 0 {
+		return -1, -1, EINVAL
+	}
+	pidfd = -1
+	sys := &syscall.SysProcAttr{
+		Cloneflags: uintptr(attr.Flags &^ (CLONE_PIDFD | CLONE_INTO_CGROUP)),
+	}
+	if attr.Flags&CLONE_PIDFD != 0 {
+		sys.PidFD = &pidfd
+	}
+	if attr.Flags&CLONE_INTO_CGROUP != 0 {
+		sys.UseCgroupFD = true
+		sys.CgroupFD = attr.Cgroup
+	}
+	pid, err = syscall.ForkExec(argv0, argv, &syscall.ProcAttr{
+		Env:   envv,
+		Files: []uintptr{0, 1, 2},
+		Sys:   sys,
+	})
+	if err != nil {
+		return -1, -1, err
+	}
+	return pid, pidfd, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux,go1.22
+
+package unix_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func clone3Exec(t *testing.T, attr *unix.Clone3Attr, argv ...string) (pid, pidfd int) {
+	path, err := exec.LookPath(argv[0])
+	if err != nil {
+		t.Skipf("%s not found: %v", argv[0], err)
+	}
+	pid, pidfd, err = unix.Clone3Exec(attr, path, argv, os.Environ())
+	if err == unix.ENOSYS {
+		t.Skip("clone3 syscall is not available, skipping test")
+	} else if err == unix.EPERM {
+		t.Skipf("clone3 with flags %#x not permitted", attr.Flags)
+	} else if err != nil {
+		t.Fatalf("Clone3Exec: %v", err)
+	}
+	return pid, pidfd
+}
+
+func TestClone3Exec(t *testing.T) {
+	pid, pidfd := clone3Exec(t, &unix.Clone3Attr{}, "sh", "-c", "exit 7")
+	if pidfd != -1 {
+		t.Errorf("Clone3Exec without CLONE_PIDFD returned pidfd %d", pidfd)
+	}
+	var ws unix.WaitStatus
+	if _, err := unix.Wait4(pid, &ws, 0, nil); err != nil {
+		t.Fatalf("Wait4: %v", err)
+	}
+	if !ws.Exited() || ws.ExitStatus() != 7 {
+		t.Errorf("child exited with status %#x, want exit status 7", ws)
+	}
+
+	_, _, err := unix.Clone3Exec(&unix.Clone3Attr{}, "/nonexistent", []string{"x"}, nil)
+	if err != unix.ENOENT {
+		t.Errorf("Clone3Exec of a missing program: %v, want ENOENT", err)
+	}
+	if _, err := unix.Wait4(-1, nil, unix.WNOHANG, nil); err != unix.ECHILD {
+		t.Errorf("Wait4 after a failed exec: %v, want ECHILD", err)
+	}
+
+	for _, flags := range []uint64{unix.CLONE_VM, unix.CLONE_SETTLS, unix.CLONE_PARENT} {
+		if _, _, err := unix.Clone3Exec(&unix.Clone3Attr{Flags: flags}, "/bin/true", nil, nil); err != unix.EINVAL {
+			t.Errorf("Clone3Exec with flags %#x: %v, want EINVAL", flags, err)
+		}
+	}
+}
+
+func TestClone3ExecNamespaces(t *testing.T) {
+	attr := &unix.Clone3Attr{Flags: unix.CLONE_PIDFD | unix.CLONE_NEWUTS | unix.CLONE_NEWPID}
+	pid, pidfd := clone3Exec(t, attr, "sleep", "60")
+	defer unix.Close(pidfd)
+	defer unix.Wait4(pid, nil, 0, nil)
+	defer unix.PidfdSendSignal(pidfd, unix.SIGKILL, nil, 0)
+
+	self, err := os.Readlink("/proc/self/ns/uts")
+	if err != nil {
+		t.Skipf("cannot read UTS namespace: %v", err)
+	}
+	child, err := os.Readlink(fmt.Sprintf("/proc/%d/ns/uts", pid))
+	if err != nil {
+		t.Fatalf("Readlink: %v", err)
+	}
+	if child == self {
+		t.Errorf("child is in UTS namespace %s of its parent", child)
+	}
+	status, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/status", pid))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if want := fmt.Sprintf("\nNSpid:\t%d\t1\n", pid); !strings.Contains(string(status), want) {
+		t.Errorf("status of the child does not contain %q:\n%s", want, status)
+	}
+	if err := unix.PidfdSendSignal(pidfd, 0, nil, 0); err != nil {
+		t.Errorf("PidfdSendSignal through the pidfd of the child: %v", err)
+	}
+}
+
+func TestClone3ExecIntoCgroup(t *testing.T) {
+	root := ""
+	for _, dir := range []string{"/sys/fs/cgroup", "/sys/fs/cgroup/unified"} {
+		var st unix.Statfs_t
+		if unix.Statfs(dir, &st) == nil && st.Type == unix.CGROUP2_SUPER_MAGIC {
+			root = dir
+			break
+		}
+	}
+	if root == "" {
+		t.Skip("cgroup v2 is not mounted")
+	}
+	dir, err := ioutil.TempDir(root, "TestClone3ExecIntoCgroup")
+	if err != nil {
+		t.Skipf("cannot create a cgroup: %v", err)
+	}
+	defer os.Remove(dir)
+	cgfd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer unix.Close(cgfd)
+
+	attr := &unix.Clone3Attr{Flags: unix.CLONE_INTO_CGROUP, Cgroup: cgfd}
+	pid, _ := clone3Exec(t, attr, "sleep", "60")
+	defer unix.Wait4(pid, nil, 0, nil)
+	defer unix.Kill(pid, unix.SIGKILL)
+
+	procs, err := ioutil.ReadFile(filepath.Join(dir, "cgroup.procs"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if want := fmt.Sprintln(pid); string(procs) != want {
+		t.Errorf("cgroup.procs = %q, want %q", procs, want)
+	}
+}
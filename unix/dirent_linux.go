@@ -0,0 +1,130 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Typed directory entries from getdents64
+
+package unix
+
+import "unsafe"
+
+// direntNameOff is the offset of the name in a struct linux_dirent64,
+// after the fixed size fields.
+const direntNameOff = int(unsafe.Offsetof(Dirent{}.Name))
+
+// DirEntry is a directory entry read with Getdents.
+type DirEntry struct {
+	Ino  uint64 // inode number
+	Off  int64  // position of the next entry, which can be passed to Seek
+	Type uint8  // DT_* type, or DT_UNKNOWN if the file system does not report it
+	Name string
+}
+
+// ParseDirEntries parses up to max directory entries in buf, which holds
+// data read with Getdents, appending them to entries. If max is negative,
+// all entries in buf are parsed. Like ParseDirent, it skips the entries
+// "." and "..". It returns the number of bytes consumed from buf, the
+// number of entries added to entries, and the new entries slice.
+func ParseDirEntries(buf []byte, max int, entries []DirEntry) (consumed int, count int, newentries []DirEntry) {
+	origlen := len(buf)
+	for max != 0 && len(buf) > direntNameOff {
+		var d Dirent
+		copy((*[direntNameOff]byte)(unsafe.Pointer(&d))[:], buf)
+		if int(d.Reclen) <= direntNameOff || int(d.Reclen) > len(buf) {
+			// Malformed; consume the rest of buf.
+			buf = buf[len(buf):]
+			break
+		}
+		name := buf[direntNameOff:d.Reclen]
+		buf = buf[d.Reclen:]
+		if d.Ino == 0 {
+			continue
+		}
+		name = name[:clen(name)]
+		if string(name) == "." || string(name) == ".." {
+			continue
+		}
+		max--
+		count++
+		entries = append(entries, DirEntry{
+			Ino:  d.Ino,
+			Off:  d.Off,
+			Type: d.Type,
+			Name: string(name),
+		})
+	}
+	return origlen - len(buf), count, entries
+}
+
+// DirReader iterates over the entries of a directory, reading them with
+// Getdents in batches:
+//
+//	d := unix.NewDirReader(fd, 0)
+//	for d.Next() {
+//		e := d.Entry()
+//		...
+//	}
+//	if err := d.Err(); err != nil {
+//		...
+//	}
+type DirReader struct {
+	fd      int
+	buf     []byte
+	bufp    int        // position of the next entry in buf
+	nbuf    int        // end of valid data in buf
+	entries []DirEntry // entries parsed from buf
+	next    int        // index of the next entry in entries
+	err     error
+}
+
+// NewDirReader returns a reader for the entries of the directory fd,
+// starting at its current position. It reads up to bufSize bytes of
+// entries per system call; if bufSize is not positive, a 64 KiB buffer is
+// used. Like ParseDirEntries, it skips "." and "..".
+func NewDirReader(fd int, bufSize int) *DirReader {
+	if bufSize <= 0 {
+		bufSize = 64 * 1024
+	}
+	return &DirReader{fd: fd, buf: make([]byte, bufSize), next: -1}
+}
+
+// Next advances to the next entry, which is then returned by Entry. It
+// returns false at the end of the directory or on an error.
+func (d *DirReader) Next() bool {
+	d.next++
+	for d.next >= len(d.entries) {
+		if d.err != nil {
+			return false
+		}
+		if d.bufp >= d.nbuf {
+			n, err := Getdents(d.fd, d.buf)
+			if err == EINTR {
+				continue
+			}
+			if err != nil {
+				d.err = err
+				return false
+			}
+			if n <= 0 {
+				return false
+			}
+			d.bufp, d.nbuf = 0, n
+		}
+		var n int
+		n, _, d.entries = ParseDirEntries(d.buf[d.bufp:d.nbuf], -1, d.entries[:0])
+		d.bufp += n
+		d.next = 0
+	}
+	return true
+}
+
+// Entry returns the entry Next advanced to.
+func (d *DirReader) Entry() DirEntry {
+	return d.entries[d.next]
+}
+
+// Err returns the error that ended the iteration, or nil at the end of
+// the directory.
+func (d *DirReader) Err() error {
+	return d.err
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// direntTree creates a directory with entries of several types and returns
+// the type of each entry by name.
+func direntTree(t *testing.T) (dir string, types map[string]uint8) {
+	dir, err := ioutil.TempDir("", "dirent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	types = map[string]uint8{"dir": unix.DT_DIR, "link": unix.DT_LNK, "fifo": unix.DT_FIFO}
+	for i := 0; i < 100; i++ {
+		name := fmt.Sprintf("file-with-a-long-name-%03d", i)
+		if err := ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		types[name] = unix.DT_REG
+	}
+	if err := os.Mkdir(filepath.Join(dir, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("dir", filepath.Join(dir, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if err := unix.Mkfifo(filepath.Join(dir, "fifo"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir, types
+}
+
+func TestDirReader(t *testing.T) {
+	dir, types := direntTree(t)
+	defer os.RemoveAll(dir)
+	fd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	// A small buffer makes the reader call Getdents many times.
+	d := unix.NewDirReader(fd, 128)
+	var names []string
+	for d.Next() {
+		e := d.Entry()
+		names = append(names, e.Name)
+		want, ok := types[e.Name]
+		if !ok {
+			t.Errorf("unexpected entry %q", e.Name)
+			continue
+		}
+		if e.Type != want && e.Type != unix.DT_UNKNOWN {
+			t.Errorf("entry %q has type %d, want %d", e.Name, e.Type, want)
+		}
+		var st unix.Stat_t
+		if err := unix.Fstatat(fd, e.Name, &st, unix.AT_SYMLINK_NOFOLLOW); err != nil {
+			t.Fatal(err)
+		}
+		if e.Ino != st.Ino {
+			t.Errorf("entry %q has inode %d, want %d", e.Name, e.Ino, st.Ino)
+		}
+	}
+	if err := d.Err(); err != nil {
+		t.Fatalf("DirReader: %v", err)
+	}
+	if d.Next() {
+		t.Errorf("Next returned true after the end of the directory")
+	}
+	if len(names) != len(types) {
+		sort.Strings(names)
+		t.Errorf("read %d entries, want %d: %q", len(names), len(types), names)
+	}
+
+	if _, err := unix.Seek(fd, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if d := unix.NewDirReader(fd, 8); d.Next() || d.Err() != unix.EINVAL {
+		t.Errorf("DirReader with a buffer too small for an entry: got error %v, want EINVAL", d.Err())
+	}
+}
+
+func TestParseDirEntries(t *testing.T) {
+	dir, types := direntTree(t)
+	defer os.RemoveAll(dir)
+	fd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	buf := make([]byte, 64*1024)
+	n, err := unix.Getdents(fd, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	consumed, count, entries := unix.ParseDirEntries(buf[:n], 3, nil)
+	if count != 3 || len(entries) != 3 || consumed <= 0 || consumed >= n {
+		t.Fatalf("ParseDirEntries with max 3 = %d, %d, %d entries", consumed, count, len(entries))
+	}
+	consumed2, count2, entries := unix.ParseDirEntries(buf[consumed:n], -1, entries)
+	if consumed+consumed2 != n || count+count2 != len(types) || len(entries) != len(types) {
+		t.Fatalf("ParseDirEntries consumed %d of %d bytes, parsed %d of %d entries", consumed+consumed2, n, count+count2, len(types))
+	}
+
+	// Off is the position after an entry, from which reading resumes.
+	e := entries[len(entries)/2]
+	if _, err := unix.Seek(fd, e.Off, 0); err != nil {
+		t.Fatal(err)
+	}
+	n, err = unix.Getdents(fd, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, rest := unix.ParseDirEntries(buf[:n], 1, nil)
+	if len(rest) != 1 || rest[0] != entries[len(entries)/2+1] {
+		t.Errorf("entry after seeking to %d: %+v, want %+v", e.Off, rest, entries[len(entries)/2+1])
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// fanotify events and permission responses
+
+package unix
+
+import "unsafe"
+
+// FanotifyMark adds, removes or modifies a mark of the fanotify group fd as
+// selected by flags, one of FAN_MARK_ADD, FAN_MARK_REMOVE and
+// FAN_MARK_FLUSH combined with other FAN_MARK_* flags, for the events in
+// mask. The mark is on the object at pathname relative to dirfd, or on
+// dirfd itself if pathname is empty. With FAN_MARK_MOUNT or
+// FAN_MARK_FILESYSTEM, it covers the whole mount or file system containing
+// the object.
+func FanotifyMark(fd int, flags uint, mask uint64, dirfd int, pathname string) error {
+	// The mask is split into two registers on 32-bit architectures,
+	// which the generated wrapper only does for signed arguments.
+	if pathname == "" {
+		return fanotifyMark(fd, flags, int64(mask), dirfd, nil)
+	}
+	p, err := BytePtrFromString(pathname)
+	if err != nil {
+		return err
+	}
+	return fanotifyMark(fd, flags, int64(mask), dirfd, p)
+}
+
+// FanotifyEvent is an event read from a fanotify group.
+type FanotifyEvent struct {
+	Mask  uint64        // FAN_* event bits
+	Fd    int           // open file descriptor for the object, or FAN_NOFD
+	Pid   int           // PID, or TID with FAN_REPORT_TID, of the process that caused the event
+	Pidfd int           // pidfd of Pid with FAN_REPORT_PIDFD, FAN_NOPIDFD or FAN_EPIDFD
+	Fids  []FanotifyFid // file identifiers with FAN_REPORT_FID or FAN_REPORT_DIR_FID
+}
+
+// FanotifyFid identifies the object of an event for groups that report
+// file identifiers instead of file descriptors.
+type FanotifyFid struct {
+	Type   uint8      // FAN_EVENT_INFO_TYPE_FID, or a DFID type for the directory of the object
+	Fsid   Fsid       // ID of the file system, as reported by Statfs
+	Handle FileHandle // handle of the object for OpenByHandleAt
+	Name   string     // for the DFID_NAME types, the name of the object in the directory
+}
+
+// ParseFanotifyEvents parses buf, which holds events read from a fanotify
+// group, into events. If buf is malformed, it returns EINVAL and the events
+// parsed before the malformed part. If only the information records of an
+// event are malformed, that event is returned last as far as it was
+// parsed, so that the caller closes its descriptors too.
+func ParseFanotifyEvents(buf []byte) ([]FanotifyEvent, error) {
+	var events []FanotifyEvent
+	for len(buf) > 0 {
+		if len(buf) < SizeofFanotifyEventMetadata {
+			return events, EINVAL
+		}
+		// Events are only aligned to 4 bytes, less than the mask needs.
+		var m FanotifyEventMetadata
+		copy((*[SizeofFanotifyEventMetadata]byte)(unsafe.Pointer(&m))[:], buf)
+		if m.Vers != FANOTIFY_METADATA_VERSION || m.Metadata_len < SizeofFanotifyEventMetadata ||
+			m.Event_len < uint32(m.Metadata_len) || int(m.Event_len) > len(buf) {
+			return events, EINVAL
+		}
+		ev := FanotifyEvent{
+			Mask:  m.Mask,
+			Fd:    int(m.Fd),
+			Pid:   int(m.Pid),
+			Pidfd: FAN_NOPIDFD,
+		}
+		err := parseFanotifyInfo(&ev, buf[m.Metadata_len:m.Event_len])
+		events = append(events, ev)
+		if err != nil {
+			return events, err
+		}
+		buf = buf[m.Event_len:]
+	}
+	return events, nil
+}
+
+// parseFanotifyInfo decodes the information records b of an event into ev.
+// Records of unknown types are skipped.
+func parseFanotifyInfo(ev *FanotifyEvent, b []byte) error {
+	for len(b) > 0 {
+		if len(b) < SizeofFanotifyEventInfoHeader {
+			return EINVAL
+		}
+		h := (*FanotifyEventInfoHeader)(unsafe.Pointer(&b[0]))
+		if int(h.Len) < SizeofFanotifyEventInfoHeader || int(h.Len) > len(b) {
+			return EINVAL
+		}
+		rec := b[:h.Len]
+		b = b[h.Len:]
+
+		switch h.Type {
+		case FAN_EVENT_INFO_TYPE_PIDFD:
+			if len(rec) < SizeofFanotifyEventInfoHeader+4 {
+				return EINVAL
+			}
+			ev.Pidfd = int(*(*int32)(unsafe.Pointer(&rec[SizeofFanotifyEventInfoHeader])))
+		case FAN_EVENT_INFO_TYPE_FID, FAN_EVENT_INFO_TYPE_DFID, FAN_EVENT_INFO_TYPE_DFID_NAME,
+			FAN_EVENT_INFO_TYPE_OLD_DFID_NAME, FAN_EVENT_INFO_TYPE_NEW_DFID_NAME:
+			fid, err := parseFanotifyFid(h.Type, rec)
+			if err != nil {
+				return err
+			}
+			ev.Fids = append(ev.Fids, fid)
+		}
+	}
+	return nil
+}
+
+// parseFanotifyFid decodes a struct fanotify_event_info_fid, which is
+// followed by a struct file_handle and, for the DFID_NAME types, a
+// NUL-terminated name.
+func parseFanotifyFid(typ uint8, rec []byte) (FanotifyFid, error) {
+	if len(rec) < SizeofFanotifyEventInfoFid+sizeofFileHandle {
+		return FanotifyFid{}, EINVAL
+	}
+	info := (*FanotifyEventInfoFid)(unsafe.Pointer(&rec[0]))
+	fh := (*fileHandle)(unsafe.Pointer(&rec[SizeofFanotifyEventInfoFid]))
+	handle := rec[SizeofFanotifyEventInfoFid+sizeofFileHandle:]
+	if int(fh.Bytes) > len(handle) {
+		return FanotifyFid{}, EINVAL
+	}
+	fid := FanotifyFid{
+		Type:   typ,
+		Fsid:   info.Fsid,
+		Handle: NewFileHandle(fh.Type, handle[:fh.Bytes]),
+	}
+	if typ != FAN_EVENT_INFO_TYPE_FID && typ != FAN_EVENT_INFO_TYPE_DFID {
+		name := handle[fh.Bytes:]
+		n := clen(name)
+		if n == len(name) {
+			return FanotifyFid{}, EINVAL
+		}
+		fid.Name = string(name[:n])
+	}
+	return fid, nil
+}
+
+// FanotifyReader reads events from a fanotify group and answers its
+// permission events.
+type FanotifyReader struct {
+	fd  int
+	buf []byte
+}
+
+// NewFanotifyReader returns a reader for the fanotify group fd, which was
+// returned by FanotifyInit.
+func NewFanotifyReader(fd int) *FanotifyReader {
+	return &FanotifyReader{fd: fd, buf: make([]byte, 16*1024)}
+}
+
+// Read waits for events unless the group was created with FAN_NONBLOCK,
+// and returns the events read. Each event with a descriptor must be closed
+// by the caller, and each permission event must be answered with Respond.
+func (r *FanotifyReader) Read() ([]FanotifyEvent, error) {
+	for {
+		n, err := Read(r.fd, r.buf)
+		if err == EINTR {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ParseFanotifyEvents(r.buf[:n])
+	}
+}
+
+// Respond answers the permission event for the descriptor fd with
+// FAN_ALLOW or FAN_DENY, optionally combined with FAN_AUDIT. The process
+// that caused the event stays blocked until it is answered; a denied
+// operation fails with EPERM.
+func (r *FanotifyReader) Respond(fd int, response uint32) error {
+	resp := FanotifyResponse{Fd: int32(fd), Response: response}
+	b := (*[SizeofFanotifyResponse]byte)(unsafe.Pointer(&resp))[:]
+	for {
+		_, err := Write(r.fd, b)
+		if err != EINTR {
+			return err
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+func fanotifyInit(t *testing.T, flags uint) int {
+	fd, err := unix.FanotifyInit(flags|unix.FAN_CLOEXEC, unix.O_RDONLY|unix.O_CLOEXEC)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("FanotifyInit: %v", err)
+	}
+	if err == unix.EINVAL && flags&unix.FAN_REPORT_FID != 0 {
+		t.Skip("FAN_REPORT_FID not supported")
+	}
+	if err != nil {
+		t.Fatalf("FanotifyInit: %v", err)
+	}
+	return fd
+}
+
+func TestFanotifyPermission(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fanotify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(path, []byte("data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fd := fanotifyInit(t, unix.FAN_CLASS_CONTENT)
+	defer unix.Close(fd)
+	if err := unix.FanotifyMark(fd, unix.FAN_MARK_ADD, unix.FAN_OPEN_PERM, unix.AT_FDCWD, path); err != nil {
+		t.Fatalf("FanotifyMark: %v", err)
+	}
+	r := unix.NewFanotifyReader(fd)
+
+	for _, tt := range []struct {
+		response uint32
+		want     error
+	}{
+		{unix.FAN_DENY, unix.EPERM},
+		{unix.FAN_ALLOW, nil},
+	} {
+		errc := make(chan error, 1)
+		go func() {
+			fd, err := unix.Open(path, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+			if err == nil {
+				unix.Close(fd)
+			}
+			errc <- err
+		}()
+
+		events, err := r.Read()
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+		if len(events) != 1 {
+			t.Fatalf("read %d events, want 1", len(events))
+		}
+		ev := events[0]
+		if ev.Mask != unix.FAN_OPEN_PERM || ev.Pid != os.Getpid() || ev.Fd < 0 || len(ev.Fids) != 0 {
+			t.Errorf("event %+v, want FAN_OPEN_PERM from PID %d with a descriptor", ev, os.Getpid())
+		}
+		if err := r.Respond(ev.Fd, tt.response); err != nil {
+			t.Fatalf("Respond: %v", err)
+		}
+		unix.Close(ev.Fd)
+		if err := <-errc; err != tt.want {
+			t.Errorf("open answered with %#x: got error %v, want %v", tt.response, err, tt.want)
+		}
+	}
+}
+
+func TestFanotifyReportFid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fanotify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fd := fanotifyInit(t, unix.FAN_CLASS_NOTIF|unix.FAN_REPORT_DFID_NAME|unix.FAN_NONBLOCK)
+	defer unix.Close(fd)
+	err = unix.FanotifyMark(fd, unix.FAN_MARK_ADD, unix.FAN_CREATE|unix.FAN_DELETE, unix.AT_FDCWD, dir)
+	if err == unix.EXDEV || err == unix.EOPNOTSUPP || err == unix.ENODEV {
+		t.Skipf("FanotifyMark on %s: %v", dir, err)
+	}
+	if err != nil {
+		t.Fatalf("FanotifyMark: %v", err)
+	}
+	dirHandle, _, err := unix.NameToHandleAt(unix.AT_FDCWD, dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var st unix.Statfs_t
+	if err := unix.Statfs(dir, &st); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+
+	// The group is non-blocking, and the events were queued by the
+	// system calls above. Events for the same name may be merged.
+	events, err := unix.NewFanotifyReader(fd).Read()
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	var mask uint64
+	for i, ev := range events {
+		mask |= ev.Mask
+		if ev.Fd != unix.FAN_NOFD || len(ev.Fids) != 1 {
+			t.Errorf("event %d: %+v, want no descriptor and one fid", i, ev)
+			continue
+		}
+		fid := ev.Fids[0]
+		if fid.Type != unix.FAN_EVENT_INFO_TYPE_DFID_NAME || fid.Name != "file" || fid.Fsid != st.Fsid {
+			t.Errorf("event %d: fid type %d, name %q, fsid %v", i, fid.Type, fid.Name, fid.Fsid)
+		}
+		if fid.Handle.Type() != dirHandle.Type() || !bytes.Equal(fid.Handle.Bytes(), dirHandle.Bytes()) {
+			t.Errorf("event %d: fid handle is not that of the directory", i)
+		}
+	}
+	if want := uint64(unix.FAN_CREATE | unix.FAN_DELETE); mask != want {
+		t.Errorf("events have mask %#x, want %#x", mask, want)
+	}
+}
+
+func TestParseFanotifyEventsMalformed(t *testing.T) {
+	m := unix.FanotifyEventMetadata{
+		Event_len:    unix.SizeofFanotifyEventMetadata,
+		Vers:         unix.FANOTIFY_METADATA_VERSION,
+		Metadata_len: unix.SizeofFanotifyEventMetadata,
+		Mask:         unix.FAN_OPEN,
+		Fd:           unix.FAN_NOFD,
+		Pid:          1,
+	}
+	b := (*[unix.SizeofFanotifyEventMetadata]byte)(unsafe.Pointer(&m))[:]
+	events, err := unix.ParseFanotifyEvents(b)
+	if err != nil || len(events) != 1 || events[0].Mask != unix.FAN_OPEN || events[0].Pid != 1 {
+		t.Fatalf("ParseFanotifyEvents = %+v, %v; want one FAN_OPEN event from PID 1", events, err)
+	}
+	for _, bad := range [][]byte{
+		b[:unix.SizeofFanotifyEventMetadata-1],
+		append(append([]byte{}, b...), 0, 0),
+	} {
+		if _, err := unix.ParseFanotifyEvents(bad); err != unix.EINVAL {
+			t.Errorf("ParseFanotifyEvents(%v): got error %v, want EINVAL", bad, err)
+		}
+	}
+
+	// An event whose information record is truncated is still returned,
+	// as its descriptor has to be closed.
+	tm := m
+	tm.Fd = 42
+	tm.Event_len = unix.SizeofFanotifyEventMetadata + 2
+	truncated := append((*[unix.SizeofFanotifyEventMetadata]byte)(unsafe.Pointer(&tm))[:], 0, 0)
+	events, err = unix.ParseFanotifyEvents(truncated)
+	if err != unix.EINVAL || len(events) != 1 || events[0].Fd != 42 {
+		t.Errorf("ParseFanotifyEvents with a truncated record = %+v, %v; want the event with fd 42 and EINVAL", events, err)
+	}
+
+	b[4]++
+	if _, err := unix.ParseFanotifyEvents(b); err != unix.EINVAL {
+		t.Errorf("ParseFanotifyEvents with version %d: got error %v, want EINVAL", b[4], err)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// File handles
+
+package unix
+
+import (
+	"encoding/binary"
+	"unsafe"
+)
+
+// fileHandle is the header of struct file_handle, which is followed by
+// Bytes bytes of the opaque f_handle.
+type fileHandle struct {
+	Bytes uint32
+	Type  int32
+}
+
+const sizeofFileHandle = int(unsafe.Sizeof(fileHandle{}))
+
+// fileHandleBuf is a struct file_handle with room for the largest handle.
+type fileHandleBuf struct {
+	fileHandle
+	Handle [MAX_HANDLE_SZ]byte
+}
+
+// FileHandle is a reference to a file that stays valid when the file is
+// renamed or moved within its file system and across reboots, as long as
+// the file system supports exporting it. It holds the handle_type and
+// f_handle fields of struct file_handle.
+type FileHandle struct {
+	typ    int32
+	handle []byte
+}
+
+// NewFileHandle returns a FileHandle of type handleType holding a copy of
+// handle.
+func NewFileHandle(handleType int32, handle []byte) FileHandle {
+	return FileHandle{typ: handleType, handle: append([]byte(nil), handle...)}
+}
+
+// Type returns the file system specific type of the handle.
+func (fh FileHandle) Type() int32 {
+	return fh.typ
+}
+
+// Size returns the length of the opaque handle.
+func (fh FileHandle) Size() int {
+	return len(fh.handle)
+}
+
+// Bytes returns a copy of the opaque handle.
+func (fh FileHandle) Bytes() []byte {
+	return append([]byte(nil), fh.handle...)
+}
+
+// MarshalBinary encodes the handle in the layout of struct file_handle,
+// with the handle_bytes and handle_type fields in little-endian byte order
+// so that the encoding does not depend on the architecture.
+func (fh FileHandle) MarshalBinary() ([]byte, error) {
+	b := make([]byte, sizeofFileHandle, sizeofFileHandle+len(fh.handle))
+	binary.LittleEndian.PutUint32(b[0:], uint32(len(fh.handle)))
+	binary.LittleEndian.PutUint32(b[4:], uint32(fh.typ))
+	return append(b, fh.handle...), nil
+}
+
+// UnmarshalBinary decodes a handle encoded by MarshalBinary. It returns
+// EINVAL if data is truncated or the handle is longer than MAX_HANDLE_SZ.
+func (fh *FileHandle) UnmarshalBinary(data []byte) error {
+	if len(data) < sizeofFileHandle {
+		return EINVAL
+	}
+	n := binary.LittleEndian.Uint32(data[0:])
+	if n > MAX_HANDLE_SZ || int(n) != len(data)-sizeofFileHandle {
+		return EINVAL
+	}
+	*fh = NewFileHandle(int32(binary.LittleEndian.Uint32(data[4:])), data[sizeofFileHandle:])
+	return nil
+}
+
+// NameToHandleAt returns a handle for the file at path relative to dirfd,
+// and the ID of the mount it is on, which matches the mount ID field of
+// /proc/self/mountinfo. If flags contains AT_SYMLINK_FOLLOW, a final
+// symbolic link is followed; with AT_EMPTY_PATH, an empty path refers to
+// dirfd itself. It returns EOPNOTSUPP if the file system does not support
+// file handles.
+func NameToHandleAt(dirfd int, path string, flags int) (handle FileHandle, mountID int, err error) {
+	var fh fileHandleBuf
+	fh.Bytes = MAX_HANDLE_SZ
+	var mid _C_int
+	if err := nameToHandleAt(dirfd, path, &fh.fileHandle, &mid, flags); err != nil {
+		return FileHandle{}, 0, err
+	}
+	return NewFileHandle(fh.Type, fh.Handle[:fh.Bytes]), int(mid), nil
+}
+
+// OpenByHandleAt opens the file referred to by handle with the open flags
+// flags. mountFD is any file descriptor on the mount the handle was
+// obtained from. The caller needs the CAP_DAC_READ_SEARCH capability. It
+// returns ESTALE if the file no longer exists.
+func OpenByHandleAt(mountFD int, handle FileHandle, flags int) (fd int, err error) {
+	if len(handle.handle) > MAX_HANDLE_SZ {
+		return -1, EINVAL
+	}
+	var fh fileHandleBuf
+	fh.Bytes = uint32(len(handle.handle))
+	fh.Type = handle.typ
+	copy(fh.Handle[:], handle.handle)
+	return openByHandleAt(mountFD, &fh.fileHandle, flags)
+}
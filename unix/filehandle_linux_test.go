@@ -0,0 +1,121 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestFileHandleMarshal(t *testing.T) {
+	fh := unix.NewFileHandle(0x81, []byte{1, 2, 3, 4, 5, 6, 7, 8})
+	b, err := fh.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{8, 0, 0, 0, 0x81, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8}
+	if !bytes.Equal(b, want) {
+		t.Fatalf("MarshalBinary = %v, want %v", b, want)
+	}
+	var got unix.FileHandle
+	if err := got.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if got.Type() != fh.Type() || !bytes.Equal(got.Bytes(), fh.Bytes()) {
+		t.Errorf("UnmarshalBinary = %#x %v, want %#x %v", got.Type(), got.Bytes(), fh.Type(), fh.Bytes())
+	}
+
+	for _, b := range [][]byte{
+		nil,
+		{0, 0, 0},
+		{9, 0, 0, 0, 0x81, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8},
+		append([]byte{0x81, 0, 0, 0, 1, 0, 0, 0}, make([]byte, 0x81)...),
+	} {
+		if err := got.UnmarshalBinary(b); err != unix.EINVAL {
+			t.Errorf("UnmarshalBinary(%v): got error %v, want EINVAL", b, err)
+		}
+	}
+}
+
+func TestNameToHandleAt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filehandle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(path, []byte("contents"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fh, mountID, err := unix.NameToHandleAt(unix.AT_FDCWD, path, 0)
+	if err == unix.EOPNOTSUPP || err == unix.ENOSYS {
+		t.Skipf("file handles not supported: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("NameToHandleAt: %v", err)
+	}
+	if fh.Size() == 0 || fh.Size() > unix.MAX_HANDLE_SZ {
+		t.Errorf("handle size %d", fh.Size())
+	}
+	dirfd, err := unix.Open(dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(dirfd)
+	fh2, mountID2, err := unix.NameToHandleAt(dirfd, "file", 0)
+	if err != nil {
+		t.Fatalf("NameToHandleAt relative to dirfd: %v", err)
+	}
+	if mountID2 != mountID || fh2.Type() != fh.Type() || !bytes.Equal(fh2.Bytes(), fh.Bytes()) {
+		t.Errorf("handles of the same file differ")
+	}
+
+	// The handle survives a rename and a round trip through its encoding.
+	if err := os.Rename(path, filepath.Join(dir, "renamed")); err != nil {
+		t.Fatal(err)
+	}
+	b, err := fh.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded unix.FileHandle
+	if err := decoded.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	fd, err := unix.OpenByHandleAt(dirfd, decoded, unix.O_RDONLY|unix.O_CLOEXEC)
+	if err == unix.EPERM {
+		t.Skip("OpenByHandleAt needs CAP_DAC_READ_SEARCH")
+	}
+	if err != nil {
+		t.Fatalf("OpenByHandleAt: %v", err)
+	}
+	buf := make([]byte, 16)
+	n, err := unix.Read(fd, buf)
+	unix.Close(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf[:n]) != "contents" {
+		t.Errorf("read %q through the handle", buf[:n])
+	}
+
+	if err := os.Remove(filepath.Join(dir, "renamed")); err != nil {
+		t.Fatal(err)
+	}
+	if fd, err := unix.OpenByHandleAt(dirfd, fh, unix.O_RDONLY|unix.O_CLOEXEC); err != unix.ESTALE {
+		if err == nil {
+			unix.Close(fd)
+		}
+		t.Errorf("OpenByHandleAt of a removed file: got error %v, want ESTALE", err)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Filesystem encryption (fscrypt) ioctls
+
+package unix
+
+import "unsafe"
+
+// IoctlSetFscryptPolicy sets the v1 encryption policy of the empty
+// directory fd, or checks that an encrypted directory has that policy,
+// with FS_IOC_SET_ENCRYPTION_POLICY. v1 policies are deprecated; new
+// directories should use IoctlSetFscryptPolicyV2.
+func IoctlSetFscryptPolicy(fd int, policy *FscryptPolicy) error {
+	return ioctl(fd, FS_IOC_SET_ENCRYPTION_POLICY, uintptr(unsafe.Pointer(policy)))
+}
+
+// IoctlSetFscryptPolicyV2 sets the v2 encryption policy of the empty
+// directory fd, or checks that an encrypted directory has that policy.
+// The key identified by policy.Master_key_identifier must have been added
+// with IoctlAddFscryptKey.
+func IoctlSetFscryptPolicyV2(fd int, policy *FscryptPolicyV2) error {
+	return ioctl(fd, FS_IOC_SET_ENCRYPTION_POLICY, uintptr(unsafe.Pointer(policy)))
+}
+
+// IoctlGetFscryptPolicy returns the encryption policy of the file fd with
+// FS_IOC_GET_ENCRYPTION_POLICY, which only supports v1 policies. It
+// returns ENODATA if the file is not encrypted and EINVAL if its policy is
+// not a v1 policy.
+func IoctlGetFscryptPolicy(fd int) (*FscryptPolicy, error) {
+	var value FscryptPolicy
+	err := ioctl(fd, FS_IOC_GET_ENCRYPTION_POLICY, uintptr(unsafe.Pointer(&value)))
+	return &value, err
+}
+
+// IoctlGetFscryptPolicyEx returns the encryption policy of the file fd,
+// of any version, with FS_IOC_GET_ENCRYPTION_POLICY_EX. It returns ENODATA
+// if the file is not encrypted.
+func IoctlGetFscryptPolicyEx(fd int) (*FscryptGetPolicyExArg, error) {
+	value := FscryptGetPolicyExArg{Size: uint64(len(FscryptGetPolicyExArg{}.Policy))}
+	err := ioctl(fd, FS_IOC_GET_ENCRYPTION_POLICY_EX, uintptr(unsafe.Pointer(&value)))
+	return &value, err
+}
+
+// Version returns the version of the policy in a, FSCRYPT_POLICY_V1 or
+// FSCRYPT_POLICY_V2.
+func (a *FscryptGetPolicyExArg) Version() uint8 {
+	return a.Policy[0]
+}
+
+// V1 returns the policy in a if it is a v1 policy, and nil otherwise.
+func (a *FscryptGetPolicyExArg) V1() *FscryptPolicy {
+	if a.Version() != FSCRYPT_POLICY_V1 {
+		return nil
+	}
+	p := *(*FscryptPolicy)(unsafe.Pointer(&a.Policy[0]))
+	return &p
+}
+
+// V2 returns the policy in a if it is a v2 policy, and nil otherwise.
+func (a *FscryptGetPolicyExArg) V2() *FscryptPolicyV2 {
+	if a.Version() != FSCRYPT_POLICY_V2 {
+		return nil
+	}
+	p := *(*FscryptPolicyV2)(unsafe.Pointer(&a.Policy[0]))
+	return &p
+}
+
+// NewFscryptKeyDescriptor returns the specifier of the key with the
+// descriptor desc, which v1 policies refer to.
+func NewFscryptKeyDescriptor(desc [FSCRYPT_KEY_DESCRIPTOR_SIZE]byte) FscryptKeySpecifier {
+	s := FscryptKeySpecifier{Type: FSCRYPT_KEY_SPEC_TYPE_DESCRIPTOR}
+	copy(s.U[:], desc[:])
+	return s
+}
+
+// NewFscryptKeyIdentifier returns the specifier of the key with the
+// identifier id, which v2 policies refer to.
+func NewFscryptKeyIdentifier(id [FSCRYPT_KEY_IDENTIFIER_SIZE]byte) FscryptKeySpecifier {
+	s := FscryptKeySpecifier{Type: FSCRYPT_KEY_SPEC_TYPE_IDENTIFIER}
+	copy(s.U[:], id[:])
+	return s
+}
+
+// Descriptor returns the key descriptor of a FSCRYPT_KEY_SPEC_TYPE_DESCRIPTOR
+// specifier.
+func (s *FscryptKeySpecifier) Descriptor() (desc [FSCRYPT_KEY_DESCRIPTOR_SIZE]byte) {
+	copy(desc[:], s.U[:])
+	return desc
+}
+
+// Identifier returns the key identifier of a FSCRYPT_KEY_SPEC_TYPE_IDENTIFIER
+// specifier.
+func (s *FscryptKeySpecifier) Identifier() (id [FSCRYPT_KEY_IDENTIFIER_SIZE]byte) {
+	copy(id[:], s.U[:])
+	return id
+}
+
+// IoctlAddFscryptKey adds the master key raw to the file system containing
+// the file fd with FS_IOC_ADD_ENCRYPTION_KEY, which unlocks the
+// directories whose policy refers to it. For a v2 key, spec must be a
+// FSCRYPT_KEY_SPEC_TYPE_IDENTIFIER specifier, whose identifier is set to
+// the one the kernel derives from raw; for a v1 key, spec holds its
+// descriptor and the caller needs the CAP_SYS_ADMIN capability.
+func IoctlAddFscryptKey(fd int, spec *FscryptKeySpecifier, raw []byte) error {
+	if len(raw) > FSCRYPT_MAX_KEY_SIZE {
+		return EINVAL
+	}
+	arg := struct {
+		FscryptAddKeyArg
+		Raw [FSCRYPT_MAX_KEY_SIZE]byte
+	}{}
+	arg.Key_spec = *spec
+	arg.Raw_size = uint32(len(raw))
+	copy(arg.Raw[:], raw)
+	err := ioctl(fd, FS_IOC_ADD_ENCRYPTION_KEY, uintptr(unsafe.Pointer(&arg)))
+	// Do not leave a copy of the key behind.
+	arg.Raw = [FSCRYPT_MAX_KEY_SIZE]byte{}
+	if err != nil {
+		return err
+	}
+	*spec = arg.Key_spec
+	return nil
+}
+
+// IoctlRemoveFscryptKey removes the calling user's claim to the master key
+// spec from the file system containing the file fd with
+// FS_IOC_REMOVE_ENCRYPTION_KEY, or every user's with
+// FS_IOC_REMOVE_ENCRYPTION_KEY_ALL_USERS if allUsers is set, which needs
+// the CAP_SYS_ADMIN capability. Once no user holds the key, it is wiped
+// and the directories using it are locked. The returned flags are
+// FSCRYPT_KEY_REMOVAL_STATUS_FLAG_FILES_BUSY if files using the key are
+// still open, so that it was only partially removed, and
+// FSCRYPT_KEY_REMOVAL_STATUS_FLAG_OTHER_USERS if other users still hold
+// it.
+func IoctlRemoveFscryptKey(fd int, spec *FscryptKeySpecifier, allUsers bool) (statusFlags uint32, err error) {
+	req := uint(FS_IOC_REMOVE_ENCRYPTION_KEY)
+	if allUsers {
+		req = FS_IOC_REMOVE_ENCRYPTION_KEY_ALL_USERS
+	}
+	arg := FscryptRemoveKeyArg{Key_spec: *spec}
+	if err := ioctl(fd, req, uintptr(unsafe.Pointer(&arg))); err != nil {
+		return 0, err
+	}
+	return arg.Removal_status_flags, nil
+}
+
+// IoctlGetFscryptKeyStatus returns the status of the master key spec on
+// the file system containing the file fd with
+// FS_IOC_GET_ENCRYPTION_KEY_STATUS. Status is one of the
+// FSCRYPT_KEY_STATUS_* values.
+func IoctlGetFscryptKeyStatus(fd int, spec *FscryptKeySpecifier) (*FscryptGetKeyStatusArg, error) {
+	value := FscryptGetKeyStatusArg{Key_spec: *spec}
+	err := ioctl(fd, FS_IOC_GET_ENCRYPTION_KEY_STATUS, uintptr(unsafe.Pointer(&value)))
+	return &value, err
+}
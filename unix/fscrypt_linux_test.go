@@ -0,0 +1,201 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// mountExt4Image formats a file system image with mkfs.ext4 and the given
+// features and mounts it on a loop device. It returns the mount point and a
+// function that unmounts and removes everything.
+func mountExt4Image(t *testing.T, features string) (string, func()) {
+	if os.Getuid() != 0 {
+		t.Skip("mounting a file system requires root")
+	}
+	if _, err := exec.LookPath("mkfs.ext4"); err != nil {
+		t.Skip("mkfs.ext4 not found")
+	}
+	dir, err := ioutil.TempDir("", "ext4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := filepath.Join(dir, "img")
+	mnt := filepath.Join(dir, "mnt")
+	if err := os.Mkdir(mnt, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(img, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(img, 64<<20); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("mkfs.ext4", "-q", "-O", features, img).CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		t.Skipf("mkfs.ext4 -O %s: %v\n%s", features, err, out)
+	}
+	if out, err := exec.Command("mount", "-o", "loop", img, mnt).CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		t.Skipf("mount -o loop: %v\n%s", err, out)
+	}
+	return mnt, func() {
+		if err := unix.Unmount(mnt, 0); err != nil {
+			t.Errorf("Unmount: %v", err)
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func openDir(t *testing.T, path string) int {
+	fd, err := unix.Open(path, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+func TestFscryptPolicyV2(t *testing.T) {
+	mnt, cleanup := mountExt4Image(t, "encrypt")
+	defer cleanup()
+	root := openDir(t, mnt)
+	defer unix.Close(root)
+
+	if _, err := unix.IoctlGetFscryptPolicyEx(root); err != unix.ENODATA {
+		if err == unix.EOPNOTSUPP || err == unix.ENOTTY {
+			t.Skipf("fscrypt not supported: %v", err)
+		}
+		t.Fatalf("IoctlGetFscryptPolicyEx of an unencrypted directory: got error %v, want ENODATA", err)
+	}
+
+	raw := make([]byte, unix.FSCRYPT_MAX_KEY_SIZE)
+	if _, err := rand.Read(raw); err != nil {
+		t.Fatal(err)
+	}
+	spec := unix.NewFscryptKeyIdentifier([unix.FSCRYPT_KEY_IDENTIFIER_SIZE]byte{})
+	if err := unix.IoctlAddFscryptKey(root, &spec, raw); err != nil {
+		t.Fatalf("IoctlAddFscryptKey: %v", err)
+	}
+	if spec.Identifier() == [unix.FSCRYPT_KEY_IDENTIFIER_SIZE]byte{} {
+		t.Fatalf("IoctlAddFscryptKey did not return the key identifier")
+	}
+	status, err := unix.IoctlGetFscryptKeyStatus(root, &spec)
+	if err != nil {
+		t.Fatalf("IoctlGetFscryptKeyStatus: %v", err)
+	}
+	if status.Status != unix.FSCRYPT_KEY_STATUS_PRESENT || status.Status_flags&unix.FSCRYPT_KEY_STATUS_FLAG_ADDED_BY_SELF == 0 {
+		t.Errorf("key status %d with flags %#x, want present and added by self", status.Status, status.Status_flags)
+	}
+
+	dir := filepath.Join(mnt, "encrypted")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	fd := openDir(t, dir)
+	policy := unix.FscryptPolicyV2{
+		Version:                   unix.FSCRYPT_POLICY_V2,
+		Contents_encryption_mode:  unix.FSCRYPT_MODE_AES_256_XTS,
+		Filenames_encryption_mode: unix.FSCRYPT_MODE_AES_256_CTS,
+		Flags:                     unix.FSCRYPT_POLICY_FLAGS_PAD_32,
+		Master_key_identifier:     spec.Identifier(),
+	}
+	err = unix.IoctlSetFscryptPolicyV2(fd, &policy)
+	if err != nil {
+		unix.Close(fd)
+		t.Fatalf("IoctlSetFscryptPolicyV2: %v", err)
+	}
+	got, err := unix.IoctlGetFscryptPolicyEx(fd)
+	if err != nil {
+		t.Fatalf("IoctlGetFscryptPolicyEx: %v", err)
+	}
+	if got.Version() != unix.FSCRYPT_POLICY_V2 || got.V1() != nil || got.V2() == nil || *got.V2() != policy {
+		t.Errorf("IoctlGetFscryptPolicyEx = version %d, %+v; want %+v", got.Version(), got.V2(), policy)
+	}
+	if _, err := unix.IoctlGetFscryptPolicy(fd); err != unix.EINVAL {
+		t.Errorf("IoctlGetFscryptPolicy of a v2 policy: got error %v, want EINVAL", err)
+	}
+	unix.Close(fd)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "secret"), []byte("plaintext"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	flags, err := unix.IoctlRemoveFscryptKey(root, &spec, false)
+	if err != nil {
+		t.Fatalf("IoctlRemoveFscryptKey: %v", err)
+	}
+	if flags != 0 {
+		t.Errorf("IoctlRemoveFscryptKey returned flags %#x", flags)
+	}
+	if status, err = unix.IoctlGetFscryptKeyStatus(root, &spec); err != nil {
+		t.Fatalf("IoctlGetFscryptKeyStatus: %v", err)
+	}
+	if status.Status != unix.FSCRYPT_KEY_STATUS_ABSENT {
+		t.Errorf("key status after removal %d, want absent", status.Status)
+	}
+	// Without the key, the names in the directory are encrypted.
+	if _, err := os.Stat(filepath.Join(dir, "secret")); !os.IsNotExist(err) {
+		t.Errorf("plaintext name visible without the key: %v", err)
+	}
+}
+
+func TestFscryptPolicyV1(t *testing.T) {
+	mnt, cleanup := mountExt4Image(t, "encrypt")
+	defer cleanup()
+	dir := filepath.Join(mnt, "encrypted")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	fd := openDir(t, dir)
+	defer unix.Close(fd)
+
+	policy := unix.FscryptPolicy{
+		Version:                   unix.FSCRYPT_POLICY_V1,
+		Contents_encryption_mode:  unix.FSCRYPT_MODE_AES_256_XTS,
+		Filenames_encryption_mode: unix.FSCRYPT_MODE_AES_256_CTS,
+		Master_key_descriptor:     [8]uint8{1, 2, 3, 4, 5, 6, 7, 8},
+	}
+	err := unix.IoctlSetFscryptPolicy(fd, &policy)
+	if err == unix.EOPNOTSUPP || err == unix.ENOTTY {
+		t.Skipf("fscrypt not supported: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("IoctlSetFscryptPolicy: %v", err)
+	}
+	got, err := unix.IoctlGetFscryptPolicy(fd)
+	if err != nil {
+		t.Fatalf("IoctlGetFscryptPolicy: %v", err)
+	}
+	if *got != policy {
+		t.Errorf("IoctlGetFscryptPolicy = %+v, want %+v", *got, policy)
+	}
+	ex, err := unix.IoctlGetFscryptPolicyEx(fd)
+	if err != nil {
+		t.Fatalf("IoctlGetFscryptPolicyEx: %v", err)
+	}
+	if ex.V2() != nil || ex.V1() == nil || *ex.V1() != policy {
+		t.Errorf("IoctlGetFscryptPolicyEx = %+v, want %+v", ex.V1(), policy)
+	}
+
+	spec := unix.NewFscryptKeyDescriptor(policy.Master_key_descriptor)
+	if spec.Descriptor() != policy.Master_key_descriptor {
+		t.Errorf("Descriptor = %v, want %v", spec.Descriptor(), policy.Master_key_descriptor)
+	}
+	status, err := unix.IoctlGetFscryptKeyStatus(fd, &spec)
+	if err != nil {
+		t.Fatalf("IoctlGetFscryptKeyStatus: %v", err)
+	}
+	if status.Status != unix.FSCRYPT_KEY_STATUS_ABSENT {
+		t.Errorf("status of a key never added %d, want absent", status.Status)
+	}
+}
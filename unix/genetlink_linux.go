@@ -0,0 +1,189 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Generic netlink family resolution and the taskstats interface
+
+package unix
+
+import "unsafe"
+
+// GenlMulticastGroup is a multicast group of a generic netlink family. ID
+// is the group to join with the NETLINK_ADD_MEMBERSHIP socket option.
+type GenlMulticastGroup struct {
+	ID   uint32
+	Name string
+}
+
+// GenlFamily is a generic netlink family as reported by the GENL_ID_CTRL
+// controller.
+type GenlFamily struct {
+	ID      uint16
+	Name    string
+	Version uint32
+	HdrSize uint32
+	MaxAttr uint32
+	Groups  []GenlMulticastGroup
+}
+
+// NewGenlMessage returns a request for the generic netlink family with the
+// given ID, with a Genlmsghdr for command cmd and version already appended
+// to its payload.
+func NewGenlMessage(family uint16, cmd, version uint8, flags uint16) *NetlinkMessage {
+	m := NewNetlinkMessage(family, flags)
+	hdr := Genlmsghdr{Cmd: cmd, Version: version}
+	m.AddData((*[GENL_HDRLEN]byte)(unsafe.Pointer(&hdr))[:])
+	return m
+}
+
+// GenlGetFamily asks the generic netlink controller on the NETLINK_GENERIC
+// socket fd for the family called name. If no such family is registered,
+// it returns ENOENT.
+func GenlGetFamily(fd int, name string) (*GenlFamily, error) {
+	m := NewGenlMessage(GENL_ID_CTRL, CTRL_CMD_GETFAMILY, 1, NLM_F_REQUEST)
+	m.AddAttrString(CTRL_ATTR_FAMILY_NAME, name)
+	msgs, err := NetlinkRequest(fd, m)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, ENOENT
+	}
+	attrs, err := msgs[0].Attrs(GENL_HDRLEN)
+	if err != nil {
+		return nil, err
+	}
+	f := new(GenlFamily)
+	for i := range attrs {
+		a := &attrs[i]
+		switch a.Type {
+		case CTRL_ATTR_FAMILY_ID:
+			f.ID = a.Uint16()
+		case CTRL_ATTR_FAMILY_NAME:
+			f.Name = a.StringValue()
+		case CTRL_ATTR_VERSION:
+			f.Version = a.Uint32()
+		case CTRL_ATTR_HDRSIZE:
+			f.HdrSize = a.Uint32()
+		case CTRL_ATTR_MAXATTR:
+			f.MaxAttr = a.Uint32()
+		case CTRL_ATTR_MCAST_GROUPS:
+			if f.Groups, err = parseGenlGroups(a); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return f, nil
+}
+
+// parseGenlGroups decodes a CTRL_ATTR_MCAST_GROUPS attribute, an array of
+// nested attributes each describing one group.
+func parseGenlGroups(a *NetlinkAttr) ([]GenlMulticastGroup, error) {
+	entries, err := a.Attrs()
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]GenlMulticastGroup, 0, len(entries))
+	for i := range entries {
+		attrs, err := entries[i].Attrs()
+		if err != nil {
+			return nil, err
+		}
+		var g GenlMulticastGroup
+		for j := range attrs {
+			switch attrs[j].Type {
+			case CTRL_ATTR_MCAST_GRP_ID:
+				g.ID = attrs[j].Uint32()
+			case CTRL_ATTR_MCAST_GRP_NAME:
+				g.Name = attrs[j].StringValue()
+			}
+		}
+		groups = append(groups, g)
+	}
+	return groups, nil
+}
+
+// TaskstatsClient requests per-task accounting from the kernel through the
+// TASKSTATS generic netlink family. The family is only available in the
+// initial network namespace, and its commands require CAP_NET_ADMIN.
+type TaskstatsClient struct {
+	fd     int
+	family uint16
+	seq    uint32
+}
+
+// NewTaskstatsClient opens a NETLINK_GENERIC socket and resolves the
+// TASKSTATS family on it.
+func NewTaskstatsClient() (*TaskstatsClient, error) {
+	fd, err := Socket(AF_NETLINK, SOCK_RAW|SOCK_CLOEXEC, NETLINK_GENERIC)
+	if err != nil {
+		return nil, err
+	}
+	if err := Bind(fd, &SockaddrNetlink{Family: AF_NETLINK}); err != nil {
+		Close(fd)
+		return nil, err
+	}
+	f, err := GenlGetFamily(fd, TASKSTATS_GENL_NAME)
+	if err != nil {
+		Close(fd)
+		return nil, err
+	}
+	return &TaskstatsClient{fd: fd, family: f.ID}, nil
+}
+
+// Close closes the netlink socket of c.
+func (c *TaskstatsClient) Close() error {
+	return Close(c.fd)
+}
+
+// PID returns the accounting statistics of the thread pid.
+func (c *TaskstatsClient) PID(pid int) (*Taskstats, error) {
+	return c.get(TASKSTATS_CMD_ATTR_PID, TASKSTATS_TYPE_AGGR_PID, pid)
+}
+
+// TGID returns the accounting statistics of the thread group tgid, summed
+// over all of its threads.
+func (c *TaskstatsClient) TGID(tgid int) (*Taskstats, error) {
+	return c.get(TASKSTATS_CMD_ATTR_TGID, TASKSTATS_TYPE_AGGR_TGID, tgid)
+}
+
+func (c *TaskstatsClient) get(cmdAttr, aggr uint16, id int) (*Taskstats, error) {
+	c.seq++
+	m := NewGenlMessage(c.family, TASKSTATS_CMD_GET, TASKSTATS_GENL_VERSION, NLM_F_REQUEST)
+	m.Header.Seq = c.seq
+	m.AddAttrUint32(cmdAttr, uint32(id))
+	msgs, err := NetlinkRequest(c.fd, m)
+	if err != nil {
+		return nil, err
+	}
+	for i := range msgs {
+		attrs, err := msgs[i].Attrs(GENL_HDRLEN)
+		if err != nil {
+			return nil, err
+		}
+		for j := range attrs {
+			if attrs[j].Type != aggr {
+				continue
+			}
+			nested, err := attrs[j].Attrs()
+			if err != nil {
+				return nil, err
+			}
+			for k := range nested {
+				if nested[k].Type == TASKSTATS_TYPE_STATS {
+					return decodeTaskstats(nested[k].Value), nil
+				}
+			}
+		}
+	}
+	return nil, EINVAL
+}
+
+// decodeTaskstats copies b into a Taskstats. Newer kernels append fields
+// to struct taskstats, which are ignored, while older ones leave the
+// trailing fields zero.
+func decodeTaskstats(b []byte) *Taskstats {
+	ts := new(Taskstats)
+	copy((*[unsafe.Sizeof(*ts)]byte)(unsafe.Pointer(ts))[:], b)
+	return ts
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"os"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestGenlGetFamily(t *testing.T) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_GENERIC)
+	if err != nil {
+		t.Skipf("generic netlink not available: %v", err)
+	}
+	defer unix.Close(fd)
+
+	f, err := unix.GenlGetFamily(fd, "nlctrl")
+	if err != nil {
+		t.Fatalf("GenlGetFamily: %v", err)
+	}
+	if f.ID != unix.GENL_ID_CTRL || f.Name != "nlctrl" {
+		t.Errorf("nlctrl family = %+v, want ID %d", f, unix.GENL_ID_CTRL)
+	}
+	found := false
+	for _, g := range f.Groups {
+		if g.Name == "notify" && g.ID != 0 {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("nlctrl groups = %+v, want a notify group", f.Groups)
+	}
+
+	if _, err := unix.GenlGetFamily(fd, "no such family"); err != unix.ENOENT {
+		t.Errorf("GenlGetFamily of a missing family: %v, want ENOENT", err)
+	}
+}
+
+func TestTaskstatsClient(t *testing.T) {
+	c, err := unix.NewTaskstatsClient()
+	if err != nil {
+		t.Skipf("taskstats not available: %v", err)
+	}
+	defer c.Close()
+
+	pid := os.Getpid()
+	ts, err := c.TGID(pid)
+	if err == unix.EPERM {
+		t.Skip("taskstats requires CAP_NET_ADMIN")
+	}
+	if err != nil {
+		t.Fatalf("TGID: %v", err)
+	}
+	if ts.Version < unix.TASKSTATS_VERSION {
+		t.Errorf("taskstats version = %d, want at least %d", ts.Version, unix.TASKSTATS_VERSION)
+	}
+
+	tid := unix.Gettid()
+	ts, err = c.PID(tid)
+	if err != nil {
+		t.Fatalf("PID: %v", err)
+	}
+	if ts.Ac_pid != uint32(tid) || ts.Ac_uid != uint32(os.Getuid()) {
+		t.Errorf("taskstats for thread %d = pid %d, uid %d; want uid %d", tid, ts.Ac_pid, ts.Ac_uid, os.Getuid())
+	}
+
+	if _, err := c.PID(1 << 30); err != unix.ESRCH {
+		t.Errorf("PID of a missing thread: %v, want ESRCH", err)
+	}
+}
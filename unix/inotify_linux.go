@@ -0,0 +1,103 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Decoding of inotify events
+
+package unix
+
+import (
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// InotifyMask is a set of IN_* event and watch flags.
+type InotifyMask uint32
+
+var inotifyMaskNames = []struct {
+	bit  InotifyMask
+	name string
+}{
+	{IN_ACCESS, "IN_ACCESS"},
+	{IN_MODIFY, "IN_MODIFY"},
+	{IN_ATTRIB, "IN_ATTRIB"},
+	{IN_CLOSE_WRITE, "IN_CLOSE_WRITE"},
+	{IN_CLOSE_NOWRITE, "IN_CLOSE_NOWRITE"},
+	{IN_OPEN, "IN_OPEN"},
+	{IN_MOVED_FROM, "IN_MOVED_FROM"},
+	{IN_MOVED_TO, "IN_MOVED_TO"},
+	{IN_CREATE, "IN_CREATE"},
+	{IN_DELETE, "IN_DELETE"},
+	{IN_DELETE_SELF, "IN_DELETE_SELF"},
+	{IN_MOVE_SELF, "IN_MOVE_SELF"},
+	{IN_UNMOUNT, "IN_UNMOUNT"},
+	{IN_Q_OVERFLOW, "IN_Q_OVERFLOW"},
+	{IN_IGNORED, "IN_IGNORED"},
+	{IN_ONLYDIR, "IN_ONLYDIR"},
+	{IN_DONT_FOLLOW, "IN_DONT_FOLLOW"},
+	{IN_EXCL_UNLINK, "IN_EXCL_UNLINK"},
+	{IN_MASK_CREATE, "IN_MASK_CREATE"},
+	{IN_MASK_ADD, "IN_MASK_ADD"},
+	{IN_ISDIR, "IN_ISDIR"},
+	{IN_ONESHOT, "IN_ONESHOT"},
+}
+
+// String returns the names of the flags in m separated by "|", followed by
+// the remaining bits in hexadecimal if there are any, for example
+// "IN_CREATE|IN_ISDIR".
+func (m InotifyMask) String() string {
+	if m == 0 {
+		return "0"
+	}
+	var names []string
+	for _, n := range inotifyMaskNames {
+		if m&n.bit != 0 {
+			names = append(names, n.name)
+			m &^= n.bit
+		}
+	}
+	if m != 0 {
+		names = append(names, "0x"+strconv.FormatUint(uint64(m), 16))
+	}
+	return strings.Join(names, "|")
+}
+
+// InotifyMessage is an event read from an inotify instance.
+type InotifyMessage struct {
+	Wd     int         // watch descriptor returned by InotifyAddWatch, or -1 for IN_Q_OVERFLOW
+	Mask   InotifyMask // events that occurred, and IN_ISDIR if the object is a directory
+	Cookie uint32      // connects the IN_MOVED_FROM and IN_MOVED_TO events of a rename
+	Name   string      // name of the object within the watched directory, if any
+}
+
+// ParseInotifyMessages parses b, which holds the bytes returned by a read
+// from an inotify instance, into events. A read returns whole events only,
+// and fails with EINVAL if the buffer cannot hold the next event, so it
+// should be at least SizeofInotifyEvent+NAME_MAX+1 bytes long. If events
+// were lost because the queue overflowed, the last event has the mask
+// IN_Q_OVERFLOW. ParseInotifyMessages returns EINVAL, together with the
+// events before it, if b ends in a truncated event.
+func ParseInotifyMessages(b []byte) ([]InotifyMessage, error) {
+	var msgs []InotifyMessage
+	for len(b) > 0 {
+		if len(b) < SizeofInotifyEvent {
+			return msgs, EINVAL
+		}
+		var h InotifyEvent
+		copy((*[SizeofInotifyEvent]byte)(unsafe.Pointer(&h))[:], b)
+		if uint64(h.Len) > uint64(len(b)-SizeofInotifyEvent) {
+			return msgs, EINVAL
+		}
+		name := b[SizeofInotifyEvent : SizeofInotifyEvent+int(h.Len)]
+		msgs = append(msgs, InotifyMessage{
+			Wd:     int(h.Wd),
+			Mask:   InotifyMask(h.Mask),
+			Cookie: h.Cookie,
+			// The name is padded with NUL bytes.
+			Name: string(name[:clen(name)]),
+		})
+		b = b[SizeofInotifyEvent+int(h.Len):]
+	}
+	return msgs, nil
+}
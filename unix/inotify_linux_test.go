@@ -0,0 +1,138 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestInotifyMaskString(t *testing.T) {
+	for _, tt := range []struct {
+		mask unix.InotifyMask
+		want string
+	}{
+		{0, "0"},
+		{unix.IN_CREATE, "IN_CREATE"},
+		{unix.IN_CREATE | unix.IN_ISDIR, "IN_CREATE|IN_ISDIR"},
+		{unix.IN_CLOSE, "IN_CLOSE_WRITE|IN_CLOSE_NOWRITE"},
+		{unix.IN_Q_OVERFLOW, "IN_Q_OVERFLOW"},
+		{unix.IN_IGNORED | 0x8000000, "IN_IGNORED|0x8000000"},
+	} {
+		if got := tt.mask.String(); got != tt.want {
+			t.Errorf("InotifyMask(%#x).String() = %q, want %q", uint32(tt.mask), got, tt.want)
+		}
+	}
+}
+
+// inotifyRecord encodes an event as the kernel does, with name padded
+// with NUL bytes to pad bytes.
+func inotifyRecord(wd int32, mask, cookie uint32, name string, pad int) []byte {
+	h := unix.InotifyEvent{Wd: wd, Mask: mask, Cookie: cookie, Len: uint32(pad)}
+	b := append([]byte{}, (*[unix.SizeofInotifyEvent]byte)(unsafe.Pointer(&h))[:]...)
+	return append(b, append([]byte(name), make([]byte, pad-len(name))...)...)
+}
+
+func TestParseInotifyMessages(t *testing.T) {
+	var b []byte
+	b = append(b, inotifyRecord(1, unix.IN_MOVED_FROM, 7, "old", 16)...)
+	b = append(b, inotifyRecord(1, unix.IN_MOVED_TO|unix.IN_ISDIR, 7, "new", 4)...)
+	b = append(b, inotifyRecord(2, unix.IN_DELETE_SELF, 0, "", 0)...)
+	b = append(b, inotifyRecord(-1, unix.IN_Q_OVERFLOW, 0, "", 0)...)
+	msgs, err := unix.ParseInotifyMessages(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []unix.InotifyMessage{
+		{Wd: 1, Mask: unix.IN_MOVED_FROM, Cookie: 7, Name: "old"},
+		{Wd: 1, Mask: unix.IN_MOVED_TO | unix.IN_ISDIR, Cookie: 7, Name: "new"},
+		{Wd: 2, Mask: unix.IN_DELETE_SELF},
+		{Wd: -1, Mask: unix.IN_Q_OVERFLOW},
+	}
+	if !reflect.DeepEqual(msgs, want) {
+		t.Errorf("ParseInotifyMessages = %+v, want %+v", msgs, want)
+	}
+
+	// Truncated events.
+	first := len(inotifyRecord(1, unix.IN_MOVED_FROM, 7, "old", 16))
+	for _, n := range []int{first + 1, first + unix.SizeofInotifyEvent, first + unix.SizeofInotifyEvent + 3} {
+		msgs, err := unix.ParseInotifyMessages(b[:n])
+		if err != unix.EINVAL || len(msgs) != 1 || msgs[0] != want[0] {
+			t.Errorf("ParseInotifyMessages of %d bytes = %+v, %v; want the first event and EINVAL", n, msgs, err)
+		}
+	}
+	if msgs, err := unix.ParseInotifyMessages(nil); err != nil || len(msgs) != 0 {
+		t.Errorf("ParseInotifyMessages(nil) = %+v, %v", msgs, err)
+	}
+}
+
+func TestInotifyRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inotify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+	wd, err := unix.InotifyAddWatch(fd, dir, unix.IN_CREATE|unix.IN_MOVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Mkdir(filepath.Join(dir, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(filepath.Join(dir, "sub"), filepath.Join(dir, "a-longer-name")); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, unix.SizeofInotifyEvent+unix.NAME_MAX+1)
+	var msgs []unix.InotifyMessage
+	for {
+		n, err := unix.Read(fd, buf)
+		if err == unix.EAGAIN {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := unix.ParseInotifyMessages(buf[:n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, m...)
+	}
+	if len(msgs) != 3 {
+		t.Fatalf("read %+v, want 3 events", msgs)
+	}
+	want := []struct {
+		mask unix.InotifyMask
+		name string
+	}{
+		{unix.IN_CREATE | unix.IN_ISDIR, "sub"},
+		{unix.IN_MOVED_FROM | unix.IN_ISDIR, "sub"},
+		{unix.IN_MOVED_TO | unix.IN_ISDIR, "a-longer-name"},
+	}
+	for i, m := range msgs {
+		if m.Wd != wd || m.Mask != want[i].mask || m.Name != want[i].name {
+			t.Errorf("event %d: %d %v %q, want %d %v %q", i, m.Wd, m.Mask, m.Name, wd, want[i].mask, want[i].name)
+		}
+	}
+	if msgs[1].Cookie == 0 || msgs[1].Cookie != msgs[2].Cookie {
+		t.Errorf("rename events have cookies %d and %d", msgs[1].Cookie, msgs[2].Cookie)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Typed wrappers for file system ioctls
+
+package unix
+
+import (
+	"math"
+	"runtime"
+	"unsafe"
+)
+
+// IoctlFileClone shares all data of the file srcFd with the file destFd,
+// replacing its contents, with the FICLONE ioctl. Both files must be on
+// the same file system, which must support reflinks, such as Btrfs or XFS.
+func IoctlFileClone(destFd, srcFd int) error {
+	return ioctl(destFd, FICLONE, uintptr(srcFd))
+}
+
+// IoctlFileCloneRange shares value.Src_length bytes at value.Src_offset of
+// the file value.Src_fd with the file destFd at value.Dest_offset, with
+// the FICLONERANGE ioctl. A length of 0 clones up to the end of the source
+// file.
+func IoctlFileCloneRange(destFd int, value *FileCloneRange) error {
+	return ioctl(destFd, FICLONERANGE, uintptr(unsafe.Pointer(value)))
+}
+
+// FileDedupeRange describes a deduplication with IoctlFileDedupeRange of
+// the Src_length bytes at Src_offset of the source file into each of the
+// destinations in Info.
+type FileDedupeRange struct {
+	Src_offset uint64
+	Src_length uint64
+	Reserved1  uint16
+	Reserved2  uint32
+	Info       []FileDedupeRangeInfo
+}
+
+// FileDedupeRangeInfo is a destination of a deduplication. Bytes_deduped
+// and Status are set by IoctlFileDedupeRange: Status is
+// FILE_DEDUPE_RANGE_SAME if the ranges were shared,
+// FILE_DEDUPE_RANGE_DIFFERS if their contents differ, or a negated errno.
+type FileDedupeRangeInfo struct {
+	Dest_fd       int64
+	Dest_offset   uint64
+	Bytes_deduped uint64
+	Status        int32
+	Reserved      uint32
+}
+
+// IoctlFileDedupeRange shares the source range described by value of the
+// file srcFd with each destination in value.Info whose contents are the
+// same, with the FIDEDUPERANGE ioctl, and stores the result for each
+// destination in value.Info. It returns EINVAL if value.Info has more
+// entries than the 16-bit dest_count field of the request can hold.
+func IoctlFileDedupeRange(srcFd int, value *FileDedupeRange) error {
+	if len(value.Info) > math.MaxUint16 {
+		return EINVAL
+	}
+	buf := make([]byte, SizeofRawFileDedupeRange+len(value.Info)*SizeofRawFileDedupeRangeInfo)
+	raw := (*RawFileDedupeRange)(unsafe.Pointer(&buf[0]))
+	raw.Src_offset = value.Src_offset
+	raw.Src_length = value.Src_length
+	raw.Dest_count = uint16(len(value.Info))
+	raw.Reserved1 = value.Reserved1
+	raw.Reserved2 = value.Reserved2
+	for i := range value.Info {
+		info := (*RawFileDedupeRangeInfo)(unsafe.Pointer(&buf[SizeofRawFileDedupeRange+i*SizeofRawFileDedupeRangeInfo]))
+		info.Dest_fd = value.Info[i].Dest_fd
+		info.Dest_offset = value.Info[i].Dest_offset
+		info.Bytes_deduped = value.Info[i].Bytes_deduped
+		info.Status = value.Info[i].Status
+		info.Reserved = value.Info[i].Reserved
+	}
+
+	err := ioctl(srcFd, FIDEDUPERANGE, uintptr(unsafe.Pointer(&buf[0])))
+	if err == nil {
+		for i := range value.Info {
+			info := (*RawFileDedupeRangeInfo)(unsafe.Pointer(&buf[SizeofRawFileDedupeRange+i*SizeofRawFileDedupeRangeInfo]))
+			value.Info[i].Bytes_deduped = info.Bytes_deduped
+			value.Info[i].Status = info.Status
+		}
+	}
+	return err
+}
+
+// fiemapBatch is the number of extents IoctlFiemap requests at a time.
+const fiemapBatch = 128
+
+// IoctlFiemap returns the extents of the file fd that overlap the length
+// bytes at start, with the FS_IOC_FIEMAP ioctl. Pass FIEMAP_MAX_OFFSET as
+// length for the whole file. flags is a combination of FIEMAP_FLAG_SYNC,
+// which writes out dirty data first, and FIEMAP_FLAG_XATTR, which maps the
+// extended attribute tree instead. Extents shared with other files have
+// FIEMAP_EXTENT_SHARED set in their flags.
+func IoctlFiemap(fd int, start, length uint64, flags uint32) ([]FiemapExtent, error) {
+	end := start + length
+	if end < start {
+		end = FIEMAP_MAX_OFFSET
+	}
+	var req struct {
+		Fiemap
+		Extents [fiemapBatch]FiemapExtent
+	}
+	var extents []FiemapExtent
+	for start < end {
+		req.Fiemap = Fiemap{
+			Start:        start,
+			Length:       end - start,
+			Flags:        flags,
+			Extent_count: fiemapBatch,
+		}
+		if err := ioctl(fd, FS_IOC_FIEMAP, uintptr(unsafe.Pointer(&req))); err != nil {
+			return nil, err
+		}
+		n := int(req.Mapped_extents)
+		if n == 0 {
+			break
+		}
+		extents = append(extents, req.Extents[:n]...)
+		last := &req.Extents[n-1]
+		if last.Flags&FIEMAP_EXTENT_LAST != 0 || n < fiemapBatch {
+			break
+		}
+		start = last.Logical + last.Length
+	}
+	return extents, nil
+}
+
+// IoctlEnableVerity enables fs-verity on the regular file fd, which must
+// be open read-only with no writers, with FS_IOC_ENABLE_VERITY. The
+// kernel builds a Merkle tree of the file contents with hashAlgorithm, one
+// of the FS_VERITY_HASH_ALG_* values, and blockSize, a power of two that
+// is usually the page size; salt is prepended to each hashed block. sig is
+// an optional PKCS#7 signature of the file digest, checked against the
+// .fs-verity keyring. Once enabled, the file is read-only and its contents
+// are verified as they are read.
+func IoctlEnableVerity(fd int, hashAlgorithm uint32, blockSize uint32, salt, sig []byte) error {
+	arg := FsverityEnableArg{
+		Version:        1,
+		Hash_algorithm: hashAlgorithm,
+		Block_size:     blockSize,
+		Salt_size:      uint32(len(salt)),
+		Sig_size:       uint32(len(sig)),
+	}
+	if len(salt) > 0 {
+		arg.Salt_ptr = uint64(uintptr(unsafe.Pointer(&salt[0])))
+	}
+	if len(sig) > 0 {
+		arg.Sig_ptr = uint64(uintptr(unsafe.Pointer(&sig[0])))
+	}
+	err := ioctl(fd, FS_IOC_ENABLE_VERITY, uintptr(unsafe.Pointer(&arg)))
+	runtime.KeepAlive(salt)
+	runtime.KeepAlive(sig)
+	return err
+}
+
+// FsverityDigest is the digest of a verity file, which authenticates its
+// whole contents.
+type FsverityDigest struct {
+	Algorithm uint16 // FS_VERITY_HASH_ALG_* value
+	Digest    []byte
+}
+
+// String returns the digest in the format of the fsverity tool, the name
+// of the algorithm followed by a colon and the digest in hexadecimal.
+func (d *FsverityDigest) String() string {
+	var alg string
+	switch d.Algorithm {
+	case FS_VERITY_HASH_ALG_SHA256:
+		alg = "sha256"
+	case FS_VERITY_HASH_ALG_SHA512:
+		alg = "sha512"
+	default:
+		alg = "alg" + itoa(int(d.Algorithm))
+	}
+	const digits = "0123456789abcdef"
+	b := make([]byte, 0, len(alg)+1+2*len(d.Digest))
+	b = append(b, alg...)
+	b = append(b, ':')
+	for _, c := range d.Digest {
+		b = append(b, digits[c>>4], digits[c&0xf])
+	}
+	return string(b)
+}
+
+// fsverityMaxDigestSize is the size of the largest digest, a SHA-512 one.
+const fsverityMaxDigestSize = 64
+
+// IoctlMeasureVerity returns the digest of the verity file fd with
+// FS_IOC_MEASURE_VERITY. It returns ENODATA if fs-verity is not enabled
+// on the file.
+func IoctlMeasureVerity(fd int) (*FsverityDigest, error) {
+	var value struct {
+		RawFsverityDigest
+		Digest [fsverityMaxDigestSize]byte
+	}
+	value.Size = fsverityMaxDigestSize
+	if err := ioctl(fd, FS_IOC_MEASURE_VERITY, uintptr(unsafe.Pointer(&value))); err != nil {
+		return nil, err
+	}
+	d := &FsverityDigest{
+		Algorithm: value.Algorithm,
+		Digest:    make([]byte, value.Size),
+	}
+	copy(d.Digest, value.Digest[:])
+	return d, nil
+}
@@ -0,0 +1,308 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// reflinkFiles creates a source file holding data and an empty destination
+// file in a temporary directory.
+func reflinkFiles(t *testing.T, data []byte) (dir string, src, dst *os.File) {
+	dir, err := ioutil.TempDir("", "reflink")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "src"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if src, err = os.Open(filepath.Join(dir, "src")); err != nil {
+		t.Fatal(err)
+	}
+	if dst, err = os.Create(filepath.Join(dir, "dst")); err != nil {
+		t.Fatal(err)
+	}
+	return dir, src, dst
+}
+
+func skipNoReflink(t *testing.T, err error) {
+	switch err {
+	case unix.EOPNOTSUPP, unix.EXDEV, unix.EINVAL, unix.ENOTTY:
+		t.Skipf("reflinks not supported in %s: %v", os.TempDir(), err)
+	}
+}
+
+func TestIoctlFileClone(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), 4096)
+	dir, src, dst := reflinkFiles(t, data)
+	defer os.RemoveAll(dir)
+	defer src.Close()
+	defer dst.Close()
+
+	err := unix.IoctlFileClone(int(dst.Fd()), int(src.Fd()))
+	skipNoReflink(t, err)
+	if err != nil {
+		t.Fatalf("IoctlFileClone: %v", err)
+	}
+	got, err := ioutil.ReadFile(dst.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("clone has different contents")
+	}
+
+	// Clone the second half over the first half.
+	half := uint64(len(data) / 2)
+	err = unix.IoctlFileCloneRange(int(dst.Fd()), &unix.FileCloneRange{
+		Src_fd:     int64(src.Fd()),
+		Src_offset: half,
+		Src_length: half,
+	})
+	if err != nil {
+		t.Fatalf("IoctlFileCloneRange: %v", err)
+	}
+	if got, err = ioutil.ReadFile(dst.Name()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got[:half], data[half:]) {
+		t.Errorf("cloned range has different contents")
+	}
+
+	extents, err := unix.IoctlFiemap(int(dst.Fd()), 0, unix.FIEMAP_MAX_OFFSET, unix.FIEMAP_FLAG_SYNC)
+	if err != nil {
+		t.Fatalf("IoctlFiemap: %v", err)
+	}
+	for _, e := range extents {
+		if e.Flags&unix.FIEMAP_EXTENT_SHARED == 0 {
+			t.Errorf("extent %+v of a clone is not shared", e)
+		}
+	}
+}
+
+func TestIoctlFileDedupeRange(t *testing.T) {
+	tooMany := unix.FileDedupeRange{Info: make([]unix.FileDedupeRangeInfo, 1<<16)}
+	if err := unix.IoctlFileDedupeRange(0, &tooMany); err != unix.EINVAL {
+		t.Errorf("IoctlFileDedupeRange with %d destinations: %v, want EINVAL", len(tooMany.Info), err)
+	}
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), 4096)
+	dir, src, dst := reflinkFiles(t, data)
+	defer os.RemoveAll(dir)
+	defer src.Close()
+	defer dst.Close()
+	if _, err := dst.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	other, err := os.Create(filepath.Join(dir, "other"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	if _, err := other.Write(bytes.ToUpper(data)); err != nil {
+		t.Fatal(err)
+	}
+
+	dedupe := unix.FileDedupeRange{
+		Src_length: uint64(len(data)),
+		Info: []unix.FileDedupeRangeInfo{
+			{Dest_fd: int64(dst.Fd())},
+			{Dest_fd: int64(other.Fd())},
+		},
+	}
+	err = unix.IoctlFileDedupeRange(int(src.Fd()), &dedupe)
+	skipNoReflink(t, err)
+	if err != nil {
+		t.Fatalf("IoctlFileDedupeRange: %v", err)
+	}
+	if info := dedupe.Info[0]; info.Status != unix.FILE_DEDUPE_RANGE_SAME || info.Bytes_deduped != uint64(len(data)) {
+		t.Errorf("identical destination: status %d, %d bytes deduped", info.Status, info.Bytes_deduped)
+	}
+	if info := dedupe.Info[1]; info.Status != unix.FILE_DEDUPE_RANGE_DIFFERS || info.Bytes_deduped != 0 {
+		t.Errorf("different destination: status %d, %d bytes deduped", info.Status, info.Bytes_deduped)
+	}
+}
+
+func TestIoctlFiemap(t *testing.T) {
+	f, err := ioutil.TempFile("", "fiemap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	// Many separate extents, with holes in between, exercise the
+	// batching of IoctlFiemap.
+	const (
+		block = 4096
+		n     = 300
+	)
+	data := bytes.Repeat([]byte{1}, block)
+	for i := 0; i < n; i++ {
+		if _, err := f.WriteAt(data, int64(2*i*block)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	extents, err := unix.IoctlFiemap(int(f.Fd()), 0, unix.FIEMAP_MAX_OFFSET, unix.FIEMAP_FLAG_SYNC)
+	if err == unix.EOPNOTSUPP || err == unix.ENOTTY {
+		t.Skipf("FIEMAP not supported in %s: %v", os.TempDir(), err)
+	}
+	if err != nil {
+		t.Fatalf("IoctlFiemap: %v", err)
+	}
+	if len(extents) != n {
+		t.Fatalf("got %d extents, want %d", len(extents), n)
+	}
+	for i, e := range extents {
+		if e.Logical != uint64(2*i*block) || e.Length != block {
+			t.Errorf("extent %d at %d with length %d, want %d and %d", i, e.Logical, e.Length, 2*i*block, block)
+		}
+		if last := e.Flags&unix.FIEMAP_EXTENT_LAST != 0; last != (i == n-1) {
+			t.Errorf("extent %d has FIEMAP_EXTENT_LAST %v", i, last)
+		}
+	}
+
+	// A range covers the extents overlapping it.
+	extents, err = unix.IoctlFiemap(int(f.Fd()), 3*block, 2*block, 0)
+	if err != nil {
+		t.Fatalf("IoctlFiemap of a range: %v", err)
+	}
+	if len(extents) != 1 || extents[0].Logical != 4*block {
+		t.Errorf("range [%d, %d) has extents %+v, want the one at %d", 3*block, 5*block, extents, 4*block)
+	}
+}
+
+// fsverityDigest computes the SHA-256 fs-verity digest of data as the
+// kernel does: the root of a Merkle tree of salted block hashes, hashed
+// together with the parameters in a struct fsverity_descriptor.
+func fsverityDigest(data []byte, blockSize int, salt []byte) []byte {
+	// The salt is zero-padded to the SHA-256 block size.
+	padded := make([]byte, (len(salt)+63)/64*64)
+	copy(padded, salt)
+	hashBlock := func(b []byte) []byte {
+		h := sha256.New()
+		h.Write(padded)
+		h.Write(b)
+		h.Write(make([]byte, blockSize-len(b)))
+		return h.Sum(nil)
+	}
+
+	var root []byte
+	if len(data) > 0 {
+		level := data
+		for len(level) > blockSize {
+			var next []byte
+			for off := 0; off < len(level); off += blockSize {
+				end := off + blockSize
+				if end > len(level) {
+					end = len(level)
+				}
+				next = append(next, hashBlock(level[off:end])...)
+			}
+			level = next
+		}
+		root = hashBlock(level)
+	}
+
+	desc := make([]byte, 256)
+	desc[0] = 1
+	desc[1] = unix.FS_VERITY_HASH_ALG_SHA256
+	for 1<<desc[2] < blockSize {
+		desc[2]++
+	}
+	desc[3] = byte(len(salt))
+	binary.LittleEndian.PutUint64(desc[8:], uint64(len(data)))
+	copy(desc[16:80], root)
+	copy(desc[80:112], salt)
+	sum := sha256.Sum256(desc)
+	return sum[:]
+}
+
+func TestIoctlVerity(t *testing.T) {
+	mnt, cleanup := mountExt4Image(t, "verity")
+	defer cleanup()
+
+	const blockSize = 4096
+	data := make([]byte, 200*blockSize+123)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	salt := []byte("saltsalt")
+	path := filepath.Join(mnt, "file")
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	switch _, err := unix.IoctlMeasureVerity(int(f.Fd())); err {
+	case unix.ENODATA:
+	case unix.EOPNOTSUPP, unix.ENOTTY:
+		t.Skipf("fs-verity not supported: %v", err)
+	default:
+		t.Fatalf("IoctlMeasureVerity before enabling: got %v, want ENODATA", err)
+	}
+	err = unix.IoctlEnableVerity(int(f.Fd()), unix.FS_VERITY_HASH_ALG_SHA256, blockSize, salt, nil)
+	switch err {
+	case nil:
+	case unix.EINVAL:
+		t.Skipf("block size %d not supported: %v", blockSize, err)
+	default:
+		t.Fatalf("IoctlEnableVerity: %v", err)
+	}
+
+	d, err := unix.IoctlMeasureVerity(int(f.Fd()))
+	if err != nil {
+		t.Fatalf("IoctlMeasureVerity: %v", err)
+	}
+	if d.Algorithm != unix.FS_VERITY_HASH_ALG_SHA256 {
+		t.Errorf("Algorithm = %d, want %d", d.Algorithm, unix.FS_VERITY_HASH_ALG_SHA256)
+	}
+	if want := fsverityDigest(data, blockSize, salt); !bytes.Equal(d.Digest, want) {
+		t.Errorf("Digest = %x, want %x", d.Digest, want)
+	}
+	if s := d.String(); len(s) != len("sha256:")+2*sha256.Size || s[:7] != "sha256:" {
+		t.Errorf("String() = %q", s)
+	}
+
+	if _, err := os.OpenFile(path, os.O_WRONLY, 0); err == nil {
+		t.Error("opened a verity file for writing")
+	}
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("contents changed after enabling fs-verity")
+	}
+}
+
+func TestFsverityDigestString(t *testing.T) {
+	for _, tt := range []struct {
+		d    unix.FsverityDigest
+		want string
+	}{
+		{unix.FsverityDigest{unix.FS_VERITY_HASH_ALG_SHA256, []byte{0x01, 0xab}}, "sha256:01ab"},
+		{unix.FsverityDigest{unix.FS_VERITY_HASH_ALG_SHA512, []byte{0xff}}, "sha512:ff"},
+		{unix.FsverityDigest{7, nil}, "alg7:"},
+	} {
+		if got := tt.d.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
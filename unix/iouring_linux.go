@@ -0,0 +1,309 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// io_uring submission and completion rings
+
+package unix
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// Ring is an io_uring instance with its submission and completion queues
+// mapped into the address space of the process. A Ring must not be used
+// concurrently from multiple goroutines.
+//
+// The buffers, socket addresses and timespecs referenced by a queued entry
+// are passed to the kernel as raw addresses. The caller must keep them
+// alive until the corresponding completion has been reaped.
+type Ring struct {
+	fd     int
+	params IoUringParams
+
+	sqRing []byte
+	cqRing []byte
+	sqeMem []byte
+
+	sqHead  *uint32
+	sqTail  *uint32
+	sqFlags *uint32
+	sqMask  uint32
+	sqArray []uint32
+	sqes    []IoUringSqe
+
+	// SQEs in [sqeHead, sqeTail) have been handed out by GetSqe but not
+	// yet made visible to the kernel.
+	sqeHead uint32
+	sqeTail uint32
+
+	cqHead *uint32
+	cqTail *uint32
+	cqMask uint32
+	cqes   []IoUringCqe
+}
+
+// NewRing creates an io_uring instance with room for at least entries
+// submission queue entries and maps its rings. If params is not nil, it is
+// passed to io_uring_setup and updated with the values filled in by the
+// kernel.
+func NewRing(entries uint32, params *IoUringParams) (*Ring, error) {
+	var p IoUringParams
+	if params != nil {
+		p = *params
+	}
+	fd, err := IoUringSetup(entries, &p)
+	if err != nil {
+		return nil, err
+	}
+	if params != nil {
+		*params = p
+	}
+	r := &Ring{fd: fd, params: p}
+	if err := r.mapRings(); err != nil {
+		r.unmapRings()
+		Close(fd)
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Ring) mapRings() (err error) {
+	p := &r.params
+	sqSize := int(p.Sq_off.Array) + int(p.Sq_entries)*4
+	cqSize := int(p.Cq_off.Cqes) + int(p.Cq_entries)*SizeofIoUringCqe
+	single := p.Features&IORING_FEAT_SINGLE_MMAP != 0
+	if single && cqSize > sqSize {
+		sqSize = cqSize
+	}
+
+	const prot = PROT_READ | PROT_WRITE
+	const flags = MAP_SHARED | MAP_POPULATE
+	if r.sqRing, err = Mmap(r.fd, IORING_OFF_SQ_RING, sqSize, prot, flags); err != nil {
+		return err
+	}
+	if single {
+		r.cqRing = r.sqRing
+	} else if r.cqRing, err = Mmap(r.fd, IORING_OFF_CQ_RING, cqSize, prot, flags); err != nil {
+		return err
+	}
+	if r.sqeMem, err = Mmap(r.fd, IORING_OFF_SQES, int(p.Sq_entries)*SizeofIoUringSqe, prot, flags); err != nil {
+		return err
+	}
+
+	r.sqHead = (*uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Head]))
+	r.sqTail = (*uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Tail]))
+	r.sqFlags = (*uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Flags]))
+	r.sqMask = *(*uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Mask]))
+	r.sqArray = (*[1 << 28]uint32)(unsafe.Pointer(&r.sqRing[p.Sq_off.Array]))[:p.Sq_entries:p.Sq_entries]
+	r.sqes = (*[1 << 24]IoUringSqe)(unsafe.Pointer(&r.sqeMem[0]))[:p.Sq_entries:p.Sq_entries]
+	r.sqeHead = *r.sqTail
+	r.sqeTail = r.sqeHead
+
+	r.cqHead = (*uint32)(unsafe.Pointer(&r.cqRing[p.Cq_off.Head]))
+	r.cqTail = (*uint32)(unsafe.Pointer(&r.cqRing[p.Cq_off.Tail]))
+	r.cqMask = *(*uint32)(unsafe.Pointer(&r.cqRing[p.Cq_off.Mask]))
+	r.cqes = (*[1 << 24]IoUringCqe)(unsafe.Pointer(&r.cqRing[p.Cq_off.Cqes]))[:p.Cq_entries:p.Cq_entries]
+	return nil
+}
+
+func (r *Ring) unmapRings() {
+	if r.sqeMem != nil {
+		Munmap(r.sqeMem)
+	}
+	if r.cqRing != nil && r.params.Features&IORING_FEAT_SINGLE_MMAP == 0 {
+		Munmap(r.cqRing)
+	}
+	if r.sqRing != nil {
+		Munmap(r.sqRing)
+	}
+	r.sqRing, r.cqRing, r.sqeMem = nil, nil, nil
+	r.sqArray, r.sqes, r.cqes = nil, nil, nil
+}
+
+// Fd returns the io_uring file descriptor, for use with IoUringRegister.
+func (r *Ring) Fd() int {
+	return r.fd
+}
+
+// Params returns the parameters the kernel set up the ring with.
+func (r *Ring) Params() IoUringParams {
+	return r.params
+}
+
+// Close unmaps the rings and closes the io_uring file descriptor.
+func (r *Ring) Close() error {
+	r.unmapRings()
+	return Close(r.fd)
+}
+
+// GetSqe returns the next free submission queue entry, or nil if the
+// submission queue is full. The returned entry is zeroed and is passed to
+// the kernel by the next call to Submit or SubmitAndWait.
+func (r *Ring) GetSqe() *IoUringSqe {
+	// The kernel advances the head as it consumes entries.
+	head := atomic.LoadUint32(r.sqHead)
+	if r.sqeTail-head >= uint32(len(r.sqes)) {
+		return nil
+	}
+	sqe := &r.sqes[r.sqeTail&r.sqMask]
+	r.sqeTail++
+	*sqe = IoUringSqe{}
+	return sqe
+}
+
+// flushSq makes the entries handed out by GetSqe visible to the kernel and
+// returns the number of entries it has not consumed yet.
+func (r *Ring) flushSq() uint32 {
+	tail := *r.sqTail
+	for ; r.sqeHead != r.sqeTail; r.sqeHead++ {
+		r.sqArray[tail&r.sqMask] = r.sqeHead & r.sqMask
+		tail++
+	}
+	// The store of the tail orders the array and SQE writes above before
+	// the kernel can observe the new entries.
+	atomic.StoreUint32(r.sqTail, tail)
+	return tail - atomic.LoadUint32(r.sqHead)
+}
+
+// Submit passes the pending submission queue entries to the kernel and
+// returns the number of entries it consumed.
+func (r *Ring) Submit() (int, error) {
+	return r.submit(0)
+}
+
+// SubmitAndWait is like Submit but also waits until at least waitNr
+// completions are available.
+func (r *Ring) SubmitAndWait(waitNr uint32) (int, error) {
+	return r.submit(waitNr)
+}
+
+func (r *Ring) submit(waitNr uint32) (int, error) {
+	pending := r.flushSq()
+	var flags uint32
+	if r.params.Flags&IORING_SETUP_SQPOLL != 0 {
+		// The kernel polling thread consumes the queue by itself and
+		// only needs to be woken up once it went idle.
+		if atomic.LoadUint32(r.sqFlags)&IORING_SQ_NEED_WAKEUP != 0 {
+			flags |= IORING_ENTER_SQ_WAKEUP
+		} else if waitNr == 0 {
+			return int(pending), nil
+		}
+	}
+	if waitNr > 0 {
+		flags |= IORING_ENTER_GETEVENTS
+	}
+	for {
+		n, err := IoUringEnter(r.fd, pending, waitNr, flags, nil)
+		if err != EINTR {
+			return n, err
+		}
+	}
+}
+
+// ReapCqe removes the next completion from the completion queue without
+// waiting. It reports false if the queue is empty.
+func (r *Ring) ReapCqe() (cqe IoUringCqe, ok bool) {
+	head := *r.cqHead
+	// The load of the tail orders the read of the entry after the
+	// kernel's write of it.
+	if head == atomic.LoadUint32(r.cqTail) {
+		return cqe, false
+	}
+	cqe = r.cqes[head&r.cqMask]
+	// The store of the head hands the slot back to the kernel only after
+	// the entry has been copied.
+	atomic.StoreUint32(r.cqHead, head+1)
+	return cqe, true
+}
+
+// ReapCqes removes up to len(cqes) completions from the completion queue
+// without waiting and returns the number of entries stored in cqes.
+func (r *Ring) ReapCqes(cqes []IoUringCqe) int {
+	head := *r.cqHead
+	tail := atomic.LoadUint32(r.cqTail)
+	n := 0
+	for ; head != tail && n < len(cqes); head++ {
+		cqes[n] = r.cqes[head&r.cqMask]
+		n++
+	}
+	atomic.StoreUint32(r.cqHead, head)
+	return n
+}
+
+// WaitCqe removes the next completion from the completion queue, waiting
+// for one to arrive if the queue is empty.
+func (r *Ring) WaitCqe() (IoUringCqe, error) {
+	for {
+		if cqe, ok := r.ReapCqe(); ok {
+			return cqe, nil
+		}
+		_, err := IoUringEnter(r.fd, 0, 1, IORING_ENTER_GETEVENTS, nil)
+		if err != nil && err != EINTR {
+			return IoUringCqe{}, err
+		}
+	}
+}
+
+func (sqe *IoUringSqe) prepRw(op uint8, fd int, addr unsafe.Pointer, n uint32, off uint64) {
+	sqe.Opcode = op
+	sqe.Fd = int32(fd)
+	sqe.Addr = uint64(uintptr(addr))
+	sqe.Len = n
+	sqe.Off = off
+}
+
+func bufAddr(p []byte) unsafe.Pointer {
+	if len(p) == 0 {
+		return nil
+	}
+	return unsafe.Pointer(&p[0])
+}
+
+// PrepRead prepares sqe to read up to len(p) bytes from fd at offset into p.
+// An offset of ^uint64(0) reads from the current file position.
+func (sqe *IoUringSqe) PrepRead(fd int, p []byte, offset uint64) {
+	sqe.prepRw(IORING_OP_READ, fd, bufAddr(p), uint32(len(p)), offset)
+}
+
+// PrepWrite prepares sqe to write p to fd at offset. An offset of
+// ^uint64(0) writes at the current file position.
+func (sqe *IoUringSqe) PrepWrite(fd int, p []byte, offset uint64) {
+	sqe.prepRw(IORING_OP_WRITE, fd, bufAddr(p), uint32(len(p)), offset)
+}
+
+// PrepFsync prepares sqe to fsync fd. Set IORING_FSYNC_DATASYNC in flags
+// for fdatasync semantics.
+func (sqe *IoUringSqe) PrepFsync(fd int, flags uint32) {
+	sqe.prepRw(IORING_OP_FSYNC, fd, nil, 0, 0)
+	sqe.Op_flags = flags
+}
+
+// PrepAccept prepares sqe to accept a connection on the listening socket
+// fd, as accept4(fd, rsa, addrlen, flags) would. rsa and addrlen may be
+// nil.
+func (sqe *IoUringSqe) PrepAccept(fd int, rsa *RawSockaddrAny, addrlen *uint32, flags int) {
+	sqe.prepRw(IORING_OP_ACCEPT, fd, unsafe.Pointer(rsa), 0, uint64(uintptr(unsafe.Pointer(addrlen))))
+	sqe.Op_flags = uint32(flags)
+}
+
+// PrepConnect prepares sqe to connect the socket fd to sa. sa must stay
+// alive until the connect completes.
+func (sqe *IoUringSqe) PrepConnect(fd int, sa Sockaddr) error {
+	ptr, n, err := sa.sockaddr()
+	if err != nil {
+		return err
+	}
+	sqe.prepRw(IORING_OP_CONNECT, fd, ptr, 0, uint64(n))
+	return nil
+}
+
+// PrepTimeout prepares sqe to complete once ts has elapsed or, if count is
+// not zero, once count other completions have been posted. Set
+// IORING_TIMEOUT_ABS in flags to interpret ts as an absolute time. The
+// timeout completes with -ETIME if it expired.
+func (sqe *IoUringSqe) PrepTimeout(ts *KernelTimespec, count uint32, flags uint32) {
+	sqe.prepRw(IORING_OP_TIMEOUT, -1, unsafe.Pointer(ts), 1, uint64(count))
+	sqe.Op_flags = flags
+}
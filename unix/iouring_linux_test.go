@@ -0,0 +1,134 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func newTestRing(t *testing.T) *unix.Ring {
+	r, err := unix.NewRing(8, nil)
+	if err == unix.ENOSYS || err == unix.EPERM {
+		t.Skipf("io_uring not available: %v", err)
+	}
+	if err != nil {
+		t.Fatalf("NewRing: %v", err)
+	}
+	return r
+}
+
+func TestRingReadWrite(t *testing.T) {
+	r := newTestRing(t)
+	defer r.Close()
+
+	f, err := ioutil.TempFile("", "TestRingReadWrite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	fd := int(f.Fd())
+
+	want := []byte("hello, io_uring")
+	sqe := r.GetSqe()
+	sqe.PrepWrite(fd, want, 0)
+	sqe.Flags = unix.IOSQE_IO_LINK
+	sqe.User_data = 1
+	sqe = r.GetSqe()
+	sqe.PrepFsync(fd, unix.IORING_FSYNC_DATASYNC)
+	sqe.User_data = 2
+	if n, err := r.SubmitAndWait(2); err != nil || n != 2 {
+		t.Fatalf("SubmitAndWait: %d, %v", n, err)
+	}
+
+	cqes := make([]unix.IoUringCqe, 4)
+	if n := r.ReapCqes(cqes); n != 2 {
+		t.Fatalf("ReapCqes: got %d completions, want 2", n)
+	}
+	if cqes[0].Data != 1 || cqes[0].Res != int32(len(want)) {
+		t.Errorf("write completion = %+v, want data 1 and res %d", cqes[0], len(want))
+	}
+	if cqes[1].Data != 2 || cqes[1].Res != 0 {
+		t.Errorf("fsync completion = %+v, want data 2 and res 0", cqes[1])
+	}
+
+	got := make([]byte, 64)
+	r.GetSqe().PrepRead(fd, got, 0)
+	if _, err := r.Submit(); err != nil {
+		t.Fatalf("Submit: %v", err)
+	}
+	cqe, err := r.WaitCqe()
+	if err != nil {
+		t.Fatalf("WaitCqe: %v", err)
+	}
+	if cqe.Res < 0 {
+		t.Fatalf("read failed: %v", unix.Errno(-cqe.Res))
+	}
+	if got = got[:cqe.Res]; !bytes.Equal(got, want) {
+		t.Errorf("read %q, want %q", got, want)
+	}
+	if _, ok := r.ReapCqe(); ok {
+		t.Error("ReapCqe returned a completion from an empty queue")
+	}
+}
+
+func TestRingTimeout(t *testing.T) {
+	r := newTestRing(t)
+	defer r.Close()
+
+	ts := &unix.KernelTimespec{Nsec: 1e6}
+	r.GetSqe().PrepTimeout(ts, 0, 0)
+	if _, err := r.SubmitAndWait(1); err != nil {
+		t.Fatalf("SubmitAndWait: %v", err)
+	}
+	cqe, err := r.WaitCqe()
+	if err != nil {
+		t.Fatalf("WaitCqe: %v", err)
+	}
+	if unix.Errno(-cqe.Res) != unix.ETIME {
+		t.Errorf("timeout completed with %d, want -ETIME", cqe.Res)
+	}
+}
+
+func TestRingFull(t *testing.T) {
+	r := newTestRing(t)
+	defer r.Close()
+
+	entries := r.Params().Sq_entries
+	for i := uint32(0); i < entries; i++ {
+		if r.GetSqe() == nil {
+			t.Fatalf("GetSqe returned nil after %d of %d entries", i, entries)
+		}
+	}
+	if r.GetSqe() != nil {
+		t.Fatal("GetSqe returned an entry from a full submission queue")
+	}
+	if n, err := r.SubmitAndWait(entries); err != nil || n != int(entries) {
+		t.Fatalf("SubmitAndWait: %d, %v", n, err)
+	}
+	if r.GetSqe() == nil {
+		t.Error("GetSqe returned nil after the queue was consumed")
+	}
+}
+
+func TestIoUringEnterSigset(t *testing.T) {
+	r := newTestRing(t)
+	defer r.Close()
+
+	// The kernel rejects a signal mask whose size is not exactly that of
+	// its own sigset_t.
+	var set unix.Sigset_t
+	set.Val[0] = 1 << (unix.SIGUSR1 - 1)
+	if _, err := unix.IoUringEnter(r.Fd(), 0, 0, unix.IORING_ENTER_GETEVENTS, &set); err != nil {
+		t.Errorf("IoUringEnter with a signal mask: %v", err)
+	}
+}
@@ -79,6 +79,18 @@ package unix
 #include <linux/net_tstamp.h>
 #include <linux/if_xdp.h>
 #include <linux/ncsi.h>
+#include <linux/io_uring.h>
+#include <linux/aio_abi.h>
+#include <linux/seccomp.h>
+#include <linux/bpf.h>
+#include <linux/sched.h>
+#include <linux/openat2.h>
+#include <linux/fanotify.h>
+#include <linux/fiemap.h>
+#include <linux/fsverity.h>
+#include <linux/loop.h>
+#include <linux/signalfd.h>
+#include <linux/capability.h>
 
 // abi/abi.h generated by mkall.go.
 #include "abi/abi.h"
@@ -151,6 +163,10 @@ struct stat {
 # define AT_EACCESS		0x200	// Test access permitted for effective IDs, not real IDs.
 #endif
 
+#ifndef AT_RECURSIVE
+# define AT_RECURSIVE		0x8000	// Apply to the entire subtree
+#endif
+
 #ifdef TCSETS2
 // On systems that have "struct termios2" use this as type Termios.
 typedef struct termios2 termios_t;
@@ -328,6 +344,146 @@ struct my_blkpg_partition {
 	unsigned char volname[BLKPG_VOLNAMELTH];
 };
 
+// The unions in io_uring_sqe are collapsed into their most commonly used
+// member, and the trailing cmd[0] member is dropped.
+struct my_io_uring_sqe {
+	__u8	opcode;
+	__u8	flags;
+	__u16	ioprio;
+	__s32	fd;
+	__u64	off;
+	__u64	addr;
+	__u32	len;
+	__u32	op_flags;
+	__u64	user_data;
+	__u16	buf_index;
+	__u16	personality;
+	__s32	splice_fd_in;
+	__u64	addr3;
+	__u64	__pad2[1];
+};
+
+// io_uring_cqe without the flexible big_cqe member used by IORING_SETUP_CQE32,
+// which would otherwise add trailing padding to the Go struct. user_data is
+// called data so that the Go field is Data without relying on cgo trimming
+// the user_ prefix.
+struct my_io_uring_cqe {
+	__u64	data;
+	__s32	res;
+	__u32	flags;
+};
+
+// siginfo_t with the union reduced to the fields set for SIGCHLD, which is
+// what waitid reports. si_code and si_errno are swapped on mips.
+struct my_siginfo {
+	int	si_signo;
+#if defined(__mips__)
+	int	si_code;
+	int	si_errno;
+#else
+	int	si_errno;
+	int	si_code;
+#endif
+	pid_t	pid __attribute__((aligned(sizeof(long))));
+	uid_t	uid;
+	int	status;
+	char	padding[128 - 6*sizeof(int) - (sizeof(long) - sizeof(int))];
+};
+
+// bpf_attr variants for the commands wrapped by this package.
+
+struct bpf_map_create_attr {
+	__u32 map_type;
+	__u32 key_size;
+	__u32 value_size;
+	__u32 max_entries;
+	__u32 map_flags;
+	__u32 inner_map_fd;
+	__u32 numa_node;
+	char map_name[BPF_OBJ_NAME_LEN];
+	__u32 map_ifindex;
+	__u32 btf_fd;
+	__u32 btf_key_type_id;
+	__u32 btf_value_type_id;
+};
+
+struct bpf_map_elem_attr {
+	__u32 map_fd;
+	__aligned_u64 key;
+	__aligned_u64 value;
+	__u64 flags;
+};
+
+struct bpf_prog_load_attr {
+	__u32 prog_type;
+	__u32 insn_cnt;
+	__aligned_u64 insns;
+	__aligned_u64 license;
+	__u32 log_level;
+	__u32 log_size;
+	__aligned_u64 log_buf;
+	__u32 kern_version;
+	__u32 prog_flags;
+	char prog_name[BPF_OBJ_NAME_LEN];
+	__u32 prog_ifindex;
+	__u32 expected_attach_type;
+};
+
+struct bpf_obj_attr {
+	__aligned_u64 pathname;
+	__u32 bpf_fd;
+	__u32 file_flags;
+};
+
+struct bpf_info_attr {
+	__u32 bpf_fd;
+	__u32 info_len;
+	__aligned_u64 info;
+};
+
+// struct bpf_insn has the register numbers in bit fields.
+struct my_bpf_insn {
+	__u8 code;
+	__u8 regs;
+	__s16 off;
+	__s32 imm;
+};
+
+// struct file_dedupe_range and struct fiemap without their flexible arrays,
+// which godefs does not support.
+struct my_file_dedupe_range {
+	__u64 src_offset;
+	__u64 src_length;
+	__u16 dest_count;
+	__u16 reserved1;
+	__u32 reserved2;
+};
+
+struct my_fiemap {
+	__u64 fm_start;
+	__u64 fm_length;
+	__u32 fm_flags;
+	__u32 fm_mapped_extents;
+	__u32 fm_extent_count;
+	__u32 fm_reserved;
+};
+
+// struct fsverity_digest without its flexible array, which godefs does not
+// support.
+struct my_fsverity_digest {
+	__u16 digest_algorithm;
+	__u16 digest_size;
+};
+
+// struct sigevent with the union of notification methods reduced to the
+// thread ID of SIGEV_THREAD_ID, since Go programs cannot use SIGEV_THREAD.
+struct my_sigevent {
+	unsigned long value;
+	int signo;
+	int notify;
+	int tid;
+	unsigned char _pad[sizeof(struct sigevent) - sizeof(unsigned long) - 3 * sizeof(int)];
+};
 */
 import "C"
 
@@ -373,6 +529,10 @@ type Rlimit C.struct_rlimit
 
 type _Gid_t C.gid_t
 
+// Siginfo is the siginfo_t filled in by Waitid. Pid, Uid and Status are
+// only valid for SIGCHLD.
+type Siginfo C.struct_my_siginfo
+
 // Files
 
 type Stat_t C.struct_stat
@@ -393,6 +553,18 @@ type FscryptPolicy C.struct_fscrypt_policy
 
 type FscryptKey C.struct_fscrypt_key
 
+type FscryptPolicyV2 C.struct_fscrypt_policy_v2
+
+type FscryptGetPolicyExArg C.struct_fscrypt_get_policy_ex_arg
+
+type FscryptKeySpecifier C.struct_fscrypt_key_specifier
+
+type FscryptAddKeyArg C.struct_fscrypt_add_key_arg
+
+type FscryptRemoveKeyArg C.struct_fscrypt_remove_key_arg
+
+type FscryptGetKeyStatusArg C.struct_fscrypt_get_key_status_arg
+
 // Structure for Keyctl
 
 type KeyctlDHParams C.struct_keyctl_dh_params
@@ -701,6 +873,7 @@ const (
 	AT_EMPTY_PATH   = C.AT_EMPTY_PATH
 	AT_FDCWD        = C.AT_FDCWD
 	AT_NO_AUTOMOUNT = C.AT_NO_AUTOMOUNT
+	AT_RECURSIVE    = C.AT_RECURSIVE
 	AT_REMOVEDIR    = C.AT_REMOVEDIR
 
 	AT_STATX_SYNC_AS_STAT = C.AT_STATX_SYNC_AS_STAT
@@ -727,6 +900,8 @@ const (
 
 type Sigset_t C.sigset_t
 
+const _C__NSIG = C._NSIG
+
 const RNDGETENTCNT = C.RNDGETENTCNT
 
 const PERF_IOC_FLAG_GROUP = C.PERF_IOC_FLAG_GROUP
@@ -1641,3 +1816,450 @@ const (
 	SOF_TIMESTAMPING_LAST = C.SOF_TIMESTAMPING_LAST
 	SOF_TIMESTAMPING_MASK = C.SOF_TIMESTAMPING_MASK
 )
+
+// io_uring
+
+type KernelTimespec C.struct___kernel_timespec
+
+type IoUringSqe C.struct_my_io_uring_sqe
+
+type IoUringCqe C.struct_my_io_uring_cqe
+
+type IoSqringOffsets C.struct_io_sqring_offsets
+
+type IoCqringOffsets C.struct_io_cqring_offsets
+
+type IoUringParams C.struct_io_uring_params
+
+const (
+	SizeofIoUringSqe    = C.sizeof_struct_my_io_uring_sqe
+	SizeofIoUringCqe    = C.sizeof_struct_my_io_uring_cqe
+	SizeofIoUringParams = C.sizeof_struct_io_uring_params
+)
+
+// generated using:
+// perl -nlE '/^\s*(IORING_OP_\w+)/ && say "$1 = C.$1"' /usr/include/linux/io_uring.h
+const (
+	IORING_OP_NOP             = C.IORING_OP_NOP
+	IORING_OP_READV           = C.IORING_OP_READV
+	IORING_OP_WRITEV          = C.IORING_OP_WRITEV
+	IORING_OP_FSYNC           = C.IORING_OP_FSYNC
+	IORING_OP_READ_FIXED      = C.IORING_OP_READ_FIXED
+	IORING_OP_WRITE_FIXED     = C.IORING_OP_WRITE_FIXED
+	IORING_OP_POLL_ADD        = C.IORING_OP_POLL_ADD
+	IORING_OP_POLL_REMOVE     = C.IORING_OP_POLL_REMOVE
+	IORING_OP_SYNC_FILE_RANGE = C.IORING_OP_SYNC_FILE_RANGE
+	IORING_OP_SENDMSG         = C.IORING_OP_SENDMSG
+	IORING_OP_RECVMSG         = C.IORING_OP_RECVMSG
+	IORING_OP_TIMEOUT         = C.IORING_OP_TIMEOUT
+	IORING_OP_TIMEOUT_REMOVE  = C.IORING_OP_TIMEOUT_REMOVE
+	IORING_OP_ACCEPT          = C.IORING_OP_ACCEPT
+	IORING_OP_ASYNC_CANCEL    = C.IORING_OP_ASYNC_CANCEL
+	IORING_OP_LINK_TIMEOUT    = C.IORING_OP_LINK_TIMEOUT
+	IORING_OP_CONNECT         = C.IORING_OP_CONNECT
+	IORING_OP_FALLOCATE       = C.IORING_OP_FALLOCATE
+	IORING_OP_OPENAT          = C.IORING_OP_OPENAT
+	IORING_OP_CLOSE           = C.IORING_OP_CLOSE
+	IORING_OP_FILES_UPDATE    = C.IORING_OP_FILES_UPDATE
+	IORING_OP_STATX           = C.IORING_OP_STATX
+	IORING_OP_READ            = C.IORING_OP_READ
+	IORING_OP_WRITE           = C.IORING_OP_WRITE
+	IORING_OP_FADVISE         = C.IORING_OP_FADVISE
+	IORING_OP_MADVISE         = C.IORING_OP_MADVISE
+	IORING_OP_SEND            = C.IORING_OP_SEND
+	IORING_OP_RECV            = C.IORING_OP_RECV
+	IORING_OP_OPENAT2         = C.IORING_OP_OPENAT2
+	IORING_OP_EPOLL_CTL       = C.IORING_OP_EPOLL_CTL
+	IORING_OP_SPLICE          = C.IORING_OP_SPLICE
+	IORING_OP_PROVIDE_BUFFERS = C.IORING_OP_PROVIDE_BUFFERS
+	IORING_OP_REMOVE_BUFFERS  = C.IORING_OP_REMOVE_BUFFERS
+	IORING_OP_TEE             = C.IORING_OP_TEE
+	IORING_OP_SHUTDOWN        = C.IORING_OP_SHUTDOWN
+	IORING_OP_RENAMEAT        = C.IORING_OP_RENAMEAT
+	IORING_OP_UNLINKAT        = C.IORING_OP_UNLINKAT
+	IORING_OP_MKDIRAT         = C.IORING_OP_MKDIRAT
+	IORING_OP_SYMLINKAT       = C.IORING_OP_SYMLINKAT
+	IORING_OP_LINKAT          = C.IORING_OP_LINKAT
+	IORING_OP_MSG_RING        = C.IORING_OP_MSG_RING
+	IORING_OP_FSETXATTR       = C.IORING_OP_FSETXATTR
+	IORING_OP_SETXATTR        = C.IORING_OP_SETXATTR
+	IORING_OP_FGETXATTR       = C.IORING_OP_FGETXATTR
+	IORING_OP_GETXATTR        = C.IORING_OP_GETXATTR
+	IORING_OP_SOCKET          = C.IORING_OP_SOCKET
+	IORING_OP_URING_CMD       = C.IORING_OP_URING_CMD
+	IORING_OP_SEND_ZC         = C.IORING_OP_SEND_ZC
+	IORING_OP_SENDMSG_ZC      = C.IORING_OP_SENDMSG_ZC
+	IORING_OP_LAST            = C.IORING_OP_LAST
+)
+
+const (
+	IORING_REGISTER_BUFFERS          = C.IORING_REGISTER_BUFFERS
+	IORING_UNREGISTER_BUFFERS        = C.IORING_UNREGISTER_BUFFERS
+	IORING_REGISTER_FILES            = C.IORING_REGISTER_FILES
+	IORING_UNREGISTER_FILES          = C.IORING_UNREGISTER_FILES
+	IORING_REGISTER_EVENTFD          = C.IORING_REGISTER_EVENTFD
+	IORING_UNREGISTER_EVENTFD        = C.IORING_UNREGISTER_EVENTFD
+	IORING_REGISTER_FILES_UPDATE     = C.IORING_REGISTER_FILES_UPDATE
+	IORING_REGISTER_EVENTFD_ASYNC    = C.IORING_REGISTER_EVENTFD_ASYNC
+	IORING_REGISTER_PROBE            = C.IORING_REGISTER_PROBE
+	IORING_REGISTER_PERSONALITY      = C.IORING_REGISTER_PERSONALITY
+	IORING_UNREGISTER_PERSONALITY    = C.IORING_UNREGISTER_PERSONALITY
+	IORING_REGISTER_RESTRICTIONS     = C.IORING_REGISTER_RESTRICTIONS
+	IORING_REGISTER_ENABLE_RINGS     = C.IORING_REGISTER_ENABLE_RINGS
+	IORING_REGISTER_FILES2           = C.IORING_REGISTER_FILES2
+	IORING_REGISTER_FILES_UPDATE2    = C.IORING_REGISTER_FILES_UPDATE2
+	IORING_REGISTER_BUFFERS2         = C.IORING_REGISTER_BUFFERS2
+	IORING_REGISTER_BUFFERS_UPDATE   = C.IORING_REGISTER_BUFFERS_UPDATE
+	IORING_REGISTER_IOWQ_AFF         = C.IORING_REGISTER_IOWQ_AFF
+	IORING_UNREGISTER_IOWQ_AFF       = C.IORING_UNREGISTER_IOWQ_AFF
+	IORING_REGISTER_IOWQ_MAX_WORKERS = C.IORING_REGISTER_IOWQ_MAX_WORKERS
+	IORING_REGISTER_RING_FDS         = C.IORING_REGISTER_RING_FDS
+	IORING_UNREGISTER_RING_FDS       = C.IORING_UNREGISTER_RING_FDS
+	IORING_REGISTER_PBUF_RING        = C.IORING_REGISTER_PBUF_RING
+	IORING_UNREGISTER_PBUF_RING      = C.IORING_UNREGISTER_PBUF_RING
+	IORING_REGISTER_SYNC_CANCEL      = C.IORING_REGISTER_SYNC_CANCEL
+	IORING_REGISTER_FILE_ALLOC_RANGE = C.IORING_REGISTER_FILE_ALLOC_RANGE
+)
+
+// Linux native AIO
+
+type Iocb C.struct_iocb
+
+type IoEvent C.struct_io_event
+
+const (
+	SizeofIocb    = C.sizeof_struct_iocb
+	SizeofIoEvent = C.sizeof_struct_io_event
+)
+
+const (
+	IOCB_CMD_PREAD   = C.IOCB_CMD_PREAD
+	IOCB_CMD_PWRITE  = C.IOCB_CMD_PWRITE
+	IOCB_CMD_FSYNC   = C.IOCB_CMD_FSYNC
+	IOCB_CMD_FDSYNC  = C.IOCB_CMD_FDSYNC
+	IOCB_CMD_POLL    = C.IOCB_CMD_POLL
+	IOCB_CMD_NOOP    = C.IOCB_CMD_NOOP
+	IOCB_CMD_PREADV  = C.IOCB_CMD_PREADV
+	IOCB_CMD_PWRITEV = C.IOCB_CMD_PWRITEV
+)
+
+// Seccomp
+
+type SeccompData C.struct_seccomp_data
+
+const SizeofSeccompData = C.sizeof_struct_seccomp_data
+
+type SeccompNotif C.struct_seccomp_notif
+
+type SeccompNotifResp C.struct_seccomp_notif_resp
+
+type SeccompNotifAddfd C.struct_seccomp_notif_addfd
+
+type SeccompNotifSizes C.struct_seccomp_notif_sizes
+
+const (
+	SizeofSeccompNotif      = C.sizeof_struct_seccomp_notif
+	SizeofSeccompNotifResp  = C.sizeof_struct_seccomp_notif_resp
+	SizeofSeccompNotifAddfd = C.sizeof_struct_seccomp_notif_addfd
+)
+
+// eBPF
+
+type BpfMapCreateAttr C.struct_bpf_map_create_attr
+
+type BpfMapElemAttr C.struct_bpf_map_elem_attr
+
+type BpfProgLoadAttr C.struct_bpf_prog_load_attr
+
+type BpfObjAttr C.struct_bpf_obj_attr
+
+type BpfInfoAttr C.struct_bpf_info_attr
+
+type BpfMapInfo C.struct_bpf_map_info
+
+type BpfInsn C.struct_my_bpf_insn
+
+const (
+	SizeofBpfMapCreateAttr = C.sizeof_struct_bpf_map_create_attr
+	SizeofBpfMapElemAttr   = C.sizeof_struct_bpf_map_elem_attr
+	SizeofBpfProgLoadAttr  = C.sizeof_struct_bpf_prog_load_attr
+	SizeofBpfObjAttr       = C.sizeof_struct_bpf_obj_attr
+	SizeofBpfInfoAttr      = C.sizeof_struct_bpf_info_attr
+	SizeofBpfMapInfo       = C.sizeof_struct_bpf_map_info
+	SizeofBpfInsn          = C.sizeof_struct_my_bpf_insn
+)
+
+const (
+	BPF_REG_0  = C.BPF_REG_0
+	BPF_REG_1  = C.BPF_REG_1
+	BPF_REG_2  = C.BPF_REG_2
+	BPF_REG_3  = C.BPF_REG_3
+	BPF_REG_4  = C.BPF_REG_4
+	BPF_REG_5  = C.BPF_REG_5
+	BPF_REG_6  = C.BPF_REG_6
+	BPF_REG_7  = C.BPF_REG_7
+	BPF_REG_8  = C.BPF_REG_8
+	BPF_REG_9  = C.BPF_REG_9
+	BPF_REG_10 = C.BPF_REG_10
+)
+
+const (
+	BPF_MAP_CREATE                  = C.BPF_MAP_CREATE
+	BPF_MAP_LOOKUP_ELEM             = C.BPF_MAP_LOOKUP_ELEM
+	BPF_MAP_UPDATE_ELEM             = C.BPF_MAP_UPDATE_ELEM
+	BPF_MAP_DELETE_ELEM             = C.BPF_MAP_DELETE_ELEM
+	BPF_MAP_GET_NEXT_KEY            = C.BPF_MAP_GET_NEXT_KEY
+	BPF_PROG_LOAD                   = C.BPF_PROG_LOAD
+	BPF_OBJ_PIN                     = C.BPF_OBJ_PIN
+	BPF_OBJ_GET                     = C.BPF_OBJ_GET
+	BPF_PROG_ATTACH                 = C.BPF_PROG_ATTACH
+	BPF_PROG_DETACH                 = C.BPF_PROG_DETACH
+	BPF_PROG_TEST_RUN               = C.BPF_PROG_TEST_RUN
+	BPF_PROG_RUN                    = C.BPF_PROG_RUN
+	BPF_PROG_GET_NEXT_ID            = C.BPF_PROG_GET_NEXT_ID
+	BPF_MAP_GET_NEXT_ID             = C.BPF_MAP_GET_NEXT_ID
+	BPF_PROG_GET_FD_BY_ID           = C.BPF_PROG_GET_FD_BY_ID
+	BPF_MAP_GET_FD_BY_ID            = C.BPF_MAP_GET_FD_BY_ID
+	BPF_OBJ_GET_INFO_BY_FD          = C.BPF_OBJ_GET_INFO_BY_FD
+	BPF_PROG_QUERY                  = C.BPF_PROG_QUERY
+	BPF_RAW_TRACEPOINT_OPEN         = C.BPF_RAW_TRACEPOINT_OPEN
+	BPF_BTF_LOAD                    = C.BPF_BTF_LOAD
+	BPF_BTF_GET_FD_BY_ID            = C.BPF_BTF_GET_FD_BY_ID
+	BPF_TASK_FD_QUERY               = C.BPF_TASK_FD_QUERY
+	BPF_MAP_LOOKUP_AND_DELETE_ELEM  = C.BPF_MAP_LOOKUP_AND_DELETE_ELEM
+	BPF_MAP_FREEZE                  = C.BPF_MAP_FREEZE
+	BPF_BTF_GET_NEXT_ID             = C.BPF_BTF_GET_NEXT_ID
+	BPF_MAP_LOOKUP_BATCH            = C.BPF_MAP_LOOKUP_BATCH
+	BPF_MAP_LOOKUP_AND_DELETE_BATCH = C.BPF_MAP_LOOKUP_AND_DELETE_BATCH
+	BPF_MAP_UPDATE_BATCH            = C.BPF_MAP_UPDATE_BATCH
+	BPF_MAP_DELETE_BATCH            = C.BPF_MAP_DELETE_BATCH
+	BPF_LINK_CREATE                 = C.BPF_LINK_CREATE
+	BPF_LINK_UPDATE                 = C.BPF_LINK_UPDATE
+	BPF_LINK_GET_FD_BY_ID           = C.BPF_LINK_GET_FD_BY_ID
+	BPF_LINK_GET_NEXT_ID            = C.BPF_LINK_GET_NEXT_ID
+	BPF_ENABLE_STATS                = C.BPF_ENABLE_STATS
+	BPF_ITER_CREATE                 = C.BPF_ITER_CREATE
+	BPF_LINK_DETACH                 = C.BPF_LINK_DETACH
+	BPF_PROG_BIND_MAP               = C.BPF_PROG_BIND_MAP
+)
+
+const (
+	BPF_MAP_TYPE_UNSPEC                = C.BPF_MAP_TYPE_UNSPEC
+	BPF_MAP_TYPE_HASH                  = C.BPF_MAP_TYPE_HASH
+	BPF_MAP_TYPE_ARRAY                 = C.BPF_MAP_TYPE_ARRAY
+	BPF_MAP_TYPE_PROG_ARRAY            = C.BPF_MAP_TYPE_PROG_ARRAY
+	BPF_MAP_TYPE_PERF_EVENT_ARRAY      = C.BPF_MAP_TYPE_PERF_EVENT_ARRAY
+	BPF_MAP_TYPE_PERCPU_HASH           = C.BPF_MAP_TYPE_PERCPU_HASH
+	BPF_MAP_TYPE_PERCPU_ARRAY          = C.BPF_MAP_TYPE_PERCPU_ARRAY
+	BPF_MAP_TYPE_STACK_TRACE           = C.BPF_MAP_TYPE_STACK_TRACE
+	BPF_MAP_TYPE_CGROUP_ARRAY          = C.BPF_MAP_TYPE_CGROUP_ARRAY
+	BPF_MAP_TYPE_LRU_HASH              = C.BPF_MAP_TYPE_LRU_HASH
+	BPF_MAP_TYPE_LRU_PERCPU_HASH       = C.BPF_MAP_TYPE_LRU_PERCPU_HASH
+	BPF_MAP_TYPE_LPM_TRIE              = C.BPF_MAP_TYPE_LPM_TRIE
+	BPF_MAP_TYPE_ARRAY_OF_MAPS         = C.BPF_MAP_TYPE_ARRAY_OF_MAPS
+	BPF_MAP_TYPE_HASH_OF_MAPS          = C.BPF_MAP_TYPE_HASH_OF_MAPS
+	BPF_MAP_TYPE_DEVMAP                = C.BPF_MAP_TYPE_DEVMAP
+	BPF_MAP_TYPE_SOCKMAP               = C.BPF_MAP_TYPE_SOCKMAP
+	BPF_MAP_TYPE_CPUMAP                = C.BPF_MAP_TYPE_CPUMAP
+	BPF_MAP_TYPE_XSKMAP                = C.BPF_MAP_TYPE_XSKMAP
+	BPF_MAP_TYPE_SOCKHASH              = C.BPF_MAP_TYPE_SOCKHASH
+	BPF_MAP_TYPE_CGROUP_STORAGE        = C.BPF_MAP_TYPE_CGROUP_STORAGE
+	BPF_MAP_TYPE_REUSEPORT_SOCKARRAY   = C.BPF_MAP_TYPE_REUSEPORT_SOCKARRAY
+	BPF_MAP_TYPE_PERCPU_CGROUP_STORAGE = C.BPF_MAP_TYPE_PERCPU_CGROUP_STORAGE
+	BPF_MAP_TYPE_QUEUE                 = C.BPF_MAP_TYPE_QUEUE
+	BPF_MAP_TYPE_STACK                 = C.BPF_MAP_TYPE_STACK
+	BPF_MAP_TYPE_SK_STORAGE            = C.BPF_MAP_TYPE_SK_STORAGE
+	BPF_MAP_TYPE_DEVMAP_HASH           = C.BPF_MAP_TYPE_DEVMAP_HASH
+	BPF_MAP_TYPE_STRUCT_OPS            = C.BPF_MAP_TYPE_STRUCT_OPS
+	BPF_MAP_TYPE_RINGBUF               = C.BPF_MAP_TYPE_RINGBUF
+	BPF_MAP_TYPE_INODE_STORAGE         = C.BPF_MAP_TYPE_INODE_STORAGE
+	BPF_MAP_TYPE_TASK_STORAGE          = C.BPF_MAP_TYPE_TASK_STORAGE
+	BPF_MAP_TYPE_BLOOM_FILTER          = C.BPF_MAP_TYPE_BLOOM_FILTER
+	BPF_MAP_TYPE_USER_RINGBUF          = C.BPF_MAP_TYPE_USER_RINGBUF
+)
+
+const (
+	BPF_PROG_TYPE_UNSPEC                  = C.BPF_PROG_TYPE_UNSPEC
+	BPF_PROG_TYPE_SOCKET_FILTER           = C.BPF_PROG_TYPE_SOCKET_FILTER
+	BPF_PROG_TYPE_KPROBE                  = C.BPF_PROG_TYPE_KPROBE
+	BPF_PROG_TYPE_SCHED_CLS               = C.BPF_PROG_TYPE_SCHED_CLS
+	BPF_PROG_TYPE_SCHED_ACT               = C.BPF_PROG_TYPE_SCHED_ACT
+	BPF_PROG_TYPE_TRACEPOINT              = C.BPF_PROG_TYPE_TRACEPOINT
+	BPF_PROG_TYPE_XDP                     = C.BPF_PROG_TYPE_XDP
+	BPF_PROG_TYPE_PERF_EVENT              = C.BPF_PROG_TYPE_PERF_EVENT
+	BPF_PROG_TYPE_CGROUP_SKB              = C.BPF_PROG_TYPE_CGROUP_SKB
+	BPF_PROG_TYPE_CGROUP_SOCK             = C.BPF_PROG_TYPE_CGROUP_SOCK
+	BPF_PROG_TYPE_LWT_IN                  = C.BPF_PROG_TYPE_LWT_IN
+	BPF_PROG_TYPE_LWT_OUT                 = C.BPF_PROG_TYPE_LWT_OUT
+	BPF_PROG_TYPE_LWT_XMIT                = C.BPF_PROG_TYPE_LWT_XMIT
+	BPF_PROG_TYPE_SOCK_OPS                = C.BPF_PROG_TYPE_SOCK_OPS
+	BPF_PROG_TYPE_SK_SKB                  = C.BPF_PROG_TYPE_SK_SKB
+	BPF_PROG_TYPE_CGROUP_DEVICE           = C.BPF_PROG_TYPE_CGROUP_DEVICE
+	BPF_PROG_TYPE_SK_MSG                  = C.BPF_PROG_TYPE_SK_MSG
+	BPF_PROG_TYPE_RAW_TRACEPOINT          = C.BPF_PROG_TYPE_RAW_TRACEPOINT
+	BPF_PROG_TYPE_CGROUP_SOCK_ADDR        = C.BPF_PROG_TYPE_CGROUP_SOCK_ADDR
+	BPF_PROG_TYPE_LWT_SEG6LOCAL           = C.BPF_PROG_TYPE_LWT_SEG6LOCAL
+	BPF_PROG_TYPE_LIRC_MODE2              = C.BPF_PROG_TYPE_LIRC_MODE2
+	BPF_PROG_TYPE_SK_REUSEPORT            = C.BPF_PROG_TYPE_SK_REUSEPORT
+	BPF_PROG_TYPE_FLOW_DISSECTOR          = C.BPF_PROG_TYPE_FLOW_DISSECTOR
+	BPF_PROG_TYPE_CGROUP_SYSCTL           = C.BPF_PROG_TYPE_CGROUP_SYSCTL
+	BPF_PROG_TYPE_RAW_TRACEPOINT_WRITABLE = C.BPF_PROG_TYPE_RAW_TRACEPOINT_WRITABLE
+	BPF_PROG_TYPE_CGROUP_SOCKOPT          = C.BPF_PROG_TYPE_CGROUP_SOCKOPT
+	BPF_PROG_TYPE_TRACING                 = C.BPF_PROG_TYPE_TRACING
+	BPF_PROG_TYPE_STRUCT_OPS              = C.BPF_PROG_TYPE_STRUCT_OPS
+	BPF_PROG_TYPE_EXT                     = C.BPF_PROG_TYPE_EXT
+	BPF_PROG_TYPE_LSM                     = C.BPF_PROG_TYPE_LSM
+	BPF_PROG_TYPE_SK_LOOKUP               = C.BPF_PROG_TYPE_SK_LOOKUP
+	BPF_PROG_TYPE_SYSCALL                 = C.BPF_PROG_TYPE_SYSCALL
+)
+
+const (
+	BPF_ANY     = C.BPF_ANY
+	BPF_NOEXIST = C.BPF_NOEXIST
+	BPF_EXIST   = C.BPF_EXIST
+	BPF_F_LOCK  = C.BPF_F_LOCK
+)
+
+const (
+	BPF_F_NO_PREALLOC    = C.BPF_F_NO_PREALLOC
+	BPF_F_NO_COMMON_LRU  = C.BPF_F_NO_COMMON_LRU
+	BPF_F_NUMA_NODE      = C.BPF_F_NUMA_NODE
+	BPF_F_RDONLY         = C.BPF_F_RDONLY
+	BPF_F_WRONLY         = C.BPF_F_WRONLY
+	BPF_F_STACK_BUILD_ID = C.BPF_F_STACK_BUILD_ID
+	BPF_F_ZERO_SEED      = C.BPF_F_ZERO_SEED
+	BPF_F_RDONLY_PROG    = C.BPF_F_RDONLY_PROG
+	BPF_F_WRONLY_PROG    = C.BPF_F_WRONLY_PROG
+	BPF_F_CLONE          = C.BPF_F_CLONE
+	BPF_F_MMAPABLE       = C.BPF_F_MMAPABLE
+	BPF_F_PRESERVE_ELEMS = C.BPF_F_PRESERVE_ELEMS
+	BPF_F_INNER_MAP      = C.BPF_F_INNER_MAP
+)
+
+// Clone3
+
+type CloneArgs C.struct_clone_args
+
+const SizeofCloneArgs = C.sizeof_struct_clone_args
+
+// New mount API
+
+type MountAttr C.struct_mount_attr
+
+const SizeofMountAttr = C.sizeof_struct_mount_attr
+
+const (
+	FSCONFIG_SET_FLAG        = C.FSCONFIG_SET_FLAG
+	FSCONFIG_SET_STRING      = C.FSCONFIG_SET_STRING
+	FSCONFIG_SET_BINARY      = C.FSCONFIG_SET_BINARY
+	FSCONFIG_SET_PATH        = C.FSCONFIG_SET_PATH
+	FSCONFIG_SET_PATH_EMPTY  = C.FSCONFIG_SET_PATH_EMPTY
+	FSCONFIG_SET_FD          = C.FSCONFIG_SET_FD
+	FSCONFIG_CMD_CREATE      = C.FSCONFIG_CMD_CREATE
+	FSCONFIG_CMD_RECONFIGURE = C.FSCONFIG_CMD_RECONFIGURE
+)
+
+// openat2
+
+type OpenHow C.struct_open_how
+
+const SizeofOpenHow = C.sizeof_struct_open_how
+
+// fanotify
+
+type FanotifyEventMetadata C.struct_fanotify_event_metadata
+
+type FanotifyEventInfoHeader C.struct_fanotify_event_info_header
+
+type FanotifyEventInfoFid C.struct_fanotify_event_info_fid
+
+type FanotifyResponse C.struct_fanotify_response
+
+const (
+	SizeofFanotifyEventMetadata   = C.sizeof_struct_fanotify_event_metadata
+	SizeofFanotifyEventInfoHeader = C.sizeof_struct_fanotify_event_info_header
+	SizeofFanotifyEventInfoFid    = C.sizeof_struct_fanotify_event_info_fid
+	SizeofFanotifyResponse        = C.sizeof_struct_fanotify_response
+)
+
+// Reflink, deduplication and extent mapping ioctls
+
+type FileCloneRange C.struct_file_clone_range
+
+type RawFileDedupeRange C.struct_my_file_dedupe_range
+
+type RawFileDedupeRangeInfo C.struct_file_dedupe_range_info
+
+type Fiemap C.struct_my_fiemap
+
+type FiemapExtent C.struct_fiemap_extent
+
+const (
+	SizeofRawFileDedupeRange     = C.sizeof_struct_my_file_dedupe_range
+	SizeofRawFileDedupeRangeInfo = C.sizeof_struct_file_dedupe_range_info
+	SizeofFiemap                 = C.sizeof_struct_my_fiemap
+	SizeofFiemapExtent           = C.sizeof_struct_fiemap_extent
+)
+
+// fs-verity
+
+type FsverityEnableArg C.struct_fsverity_enable_arg
+
+type RawFsverityDigest C.struct_my_fsverity_digest
+
+const (
+	SizeofFsverityEnableArg = C.sizeof_struct_fsverity_enable_arg
+	SizeofRawFsverityDigest = C.sizeof_struct_my_fsverity_digest
+)
+
+// Loop devices
+
+type LoopInfo64 C.struct_loop_info64
+
+type LoopConfig C.struct_loop_config
+
+const (
+	SizeofLoopInfo64 = C.sizeof_struct_loop_info64
+	SizeofLoopConfig = C.sizeof_struct_loop_config
+)
+
+const (
+	LO_FLAGS_READ_ONLY = C.LO_FLAGS_READ_ONLY
+	LO_FLAGS_AUTOCLEAR = C.LO_FLAGS_AUTOCLEAR
+	LO_FLAGS_PARTSCAN  = C.LO_FLAGS_PARTSCAN
+	LO_FLAGS_DIRECT_IO = C.LO_FLAGS_DIRECT_IO
+)
+
+// signalfd
+
+type SignalfdSiginfo C.struct_signalfd_siginfo
+
+const SizeofSignalfdSiginfo = C.sizeof_struct_signalfd_siginfo
+
+// Timers
+
+type Itimerspec C.struct_itimerspec
+
+type Sigevent C.struct_my_sigevent
+
+const (
+	SIGEV_SIGNAL    = C.SIGEV_SIGNAL
+	SIGEV_NONE      = C.SIGEV_NONE
+	SIGEV_THREAD    = C.SIGEV_THREAD
+	SIGEV_THREAD_ID = C.SIGEV_THREAD_ID
+)
+
+// Capabilities
+
+type CapUserHeader C.struct___user_cap_header_struct
+
+type CapUserData C.struct___user_cap_data_struct
+
+const (
+	LINUX_CAPABILITY_VERSION_1 = C._LINUX_CAPABILITY_VERSION_1
+	LINUX_CAPABILITY_VERSION_2 = C._LINUX_CAPABILITY_VERSION_2
+	LINUX_CAPABILITY_VERSION_3 = C._LINUX_CAPABILITY_VERSION_3
+	LINUX_CAPABILITY_U32S_1    = C._LINUX_CAPABILITY_U32S_1
+	LINUX_CAPABILITY_U32S_2    = C._LINUX_CAPABILITY_U32S_2
+	LINUX_CAPABILITY_U32S_3    = C._LINUX_CAPABILITY_U32S_3
+)
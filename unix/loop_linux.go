@@ -0,0 +1,117 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Loop device setup
+
+package unix
+
+import "unsafe"
+
+// IoctlLoopGetStatus64 returns the status of the loop device fd with
+// LOOP_GET_STATUS64. It returns ENXIO if no file is attached to it.
+func IoctlLoopGetStatus64(fd int) (*LoopInfo64, error) {
+	var value LoopInfo64
+	err := ioctl(fd, LOOP_GET_STATUS64, uintptr(unsafe.Pointer(&value)))
+	return &value, err
+}
+
+// IoctlLoopSetStatus64 changes the offset, size limit and flags of the
+// loop device fd with LOOP_SET_STATUS64. Of the flags, only
+// LO_FLAGS_AUTOCLEAR and LO_FLAGS_PARTSCAN can be changed this way.
+func IoctlLoopSetStatus64(fd int, value *LoopInfo64) error {
+	return ioctl(fd, LOOP_SET_STATUS64, uintptr(unsafe.Pointer(value)))
+}
+
+// IoctlLoopConfigure attaches the file value.Fd to the loop device fd and
+// sets its status from value.Info in one step with LOOP_CONFIGURE, which
+// Linux supports since 5.8.
+func IoctlLoopConfigure(fd int, value *LoopConfig) error {
+	return ioctl(fd, LOOP_CONFIGURE, uintptr(unsafe.Pointer(value)))
+}
+
+// loopAttachTries is the number of free loop devices AttachLoopDevice
+// tries before giving up, as each may be taken by another process first.
+const loopAttachTries = 16
+
+// AttachLoopDevice attaches the file backingFd to a free loop device,
+// which exposes the sizelimit bytes of the file at offset, or all of the
+// file after offset if sizelimit is 0, as a block device. flags is a
+// combination of LO_FLAGS_READ_ONLY, LO_FLAGS_AUTOCLEAR, which detaches
+// the device when it is last closed, LO_FLAGS_PARTSCAN and
+// LO_FLAGS_DIRECT_IO. It returns a descriptor for the loop device, whose
+// path is /dev/loop followed by number.
+func AttachLoopDevice(backingFd int, offset, sizelimit uint64, flags uint32) (loopFd, number int, err error) {
+	ctl, err := Open("/dev/loop-control", O_RDWR|O_CLOEXEC, 0)
+	if err != nil {
+		return -1, -1, err
+	}
+	defer Close(ctl)
+
+	mode := O_RDWR
+	if flags&LO_FLAGS_READ_ONLY != 0 {
+		mode = O_RDONLY
+	}
+	for i := 0; i < loopAttachTries; i++ {
+		number, err = IoctlRetInt(ctl, LOOP_CTL_GET_FREE)
+		if err != nil {
+			return -1, -1, err
+		}
+		loopFd, err = Open("/dev/loop"+itoa(number), mode|O_CLOEXEC, 0)
+		if err != nil {
+			return -1, -1, err
+		}
+		err = loopConfigure(loopFd, backingFd, offset, sizelimit, flags)
+		if err == nil {
+			return loopFd, number, nil
+		}
+		Close(loopFd)
+		if err != EBUSY {
+			return -1, -1, err
+		}
+	}
+	return -1, -1, EBUSY
+}
+
+// loopConfigure attaches backingFd to the loop device fd with
+// LOOP_CONFIGURE, or with LOOP_SET_FD and LOOP_SET_STATUS64 on kernels
+// without it. It returns EBUSY if the device is already in use.
+func loopConfigure(fd, backingFd int, offset, sizelimit uint64, flags uint32) error {
+	cfg := LoopConfig{Fd: uint32(backingFd)}
+	cfg.Info.Offset = offset
+	cfg.Info.Sizelimit = sizelimit
+	cfg.Info.Flags = flags
+	err := IoctlLoopConfigure(fd, &cfg)
+	if err != EINVAL && err != ENOTTY {
+		return err
+	}
+	return loopSetFd(fd, backingFd, &cfg.Info)
+}
+
+// loopSetFd attaches backingFd to the loop device fd with LOOP_SET_FD and
+// sets its status to info with LOOP_SET_STATUS64, detaching it again if
+// that fails. LOOP_SET_FD makes the device read-only if fd was opened
+// read-only, and LOOP_SET_STATUS64 ignores the flags it cannot set.
+func loopSetFd(fd, backingFd int, info *LoopInfo64) error {
+	if err := IoctlSetInt(fd, LOOP_SET_FD, backingFd); err != nil {
+		return err
+	}
+	if err := IoctlLoopSetStatus64(fd, info); err != nil {
+		IoctlSetInt(fd, LOOP_CLR_FD, 0)
+		return err
+	}
+	if info.Flags&LO_FLAGS_DIRECT_IO != 0 {
+		if err := IoctlSetInt(fd, LOOP_SET_DIRECT_IO, 1); err != nil {
+			IoctlSetInt(fd, LOOP_CLR_FD, 0)
+			return err
+		}
+	}
+	return nil
+}
+
+// DetachLoopDevice detaches the backing file from the loop device loopFd
+// with LOOP_CLR_FD. If the device is still open elsewhere, it is detached
+// once it is last closed. The caller must still close loopFd.
+func DetachLoopDevice(loopFd int) error {
+	return IoctlSetInt(loopFd, LOOP_CLR_FD, 0)
+}
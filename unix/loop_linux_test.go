@@ -0,0 +1,147 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// loopBackingFile creates a 1MiB file whose 512-byte sectors hold their
+// number, skipping the test if loop devices cannot be used.
+func loopBackingFile(t *testing.T) (*os.File, []byte) {
+	if os.Getuid() != 0 {
+		t.Skip("attaching a loop device requires root")
+	}
+	if _, err := os.Stat("/dev/loop-control"); err != nil {
+		t.Skipf("no loop devices: %v", err)
+	}
+	f, err := ioutil.TempFile("", "loop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Remove(f.Name())
+	data := make([]byte, 1<<20)
+	for i := range data {
+		data[i] = byte(i / 512)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		t.Fatal(err)
+	}
+	return f, data
+}
+
+func TestAttachLoopDevice(t *testing.T) {
+	f, data := loopBackingFile(t)
+	defer f.Close()
+
+	for _, tt := range []struct {
+		name              string
+		offset, sizelimit uint64
+		flags             uint32
+	}{
+		{"whole", 0, 0, 0},
+		{"readonly", 4096, 64 << 10, unix.LO_FLAGS_READ_ONLY},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fd, n, err := unix.AttachLoopDevice(int(f.Fd()), tt.offset, tt.sizelimit, tt.flags)
+			if err != nil {
+				t.Fatalf("AttachLoopDevice: %v", err)
+			}
+			defer unix.Close(fd)
+			t.Logf("attached /dev/loop%d", n)
+
+			info, err := unix.IoctlLoopGetStatus64(fd)
+			if err != nil {
+				t.Fatalf("IoctlLoopGetStatus64: %v", err)
+			}
+			if info.Number != uint32(n) || info.Offset != tt.offset || info.Sizelimit != tt.sizelimit {
+				t.Errorf("status: number %d, offset %d, sizelimit %d; want %d, %d, %d",
+					info.Number, info.Offset, info.Sizelimit, n, tt.offset, tt.sizelimit)
+			}
+			if info.Flags&unix.LO_FLAGS_READ_ONLY != tt.flags&unix.LO_FLAGS_READ_ONLY {
+				t.Errorf("flags = %#x, want read-only %v", info.Flags, tt.flags&unix.LO_FLAGS_READ_ONLY != 0)
+			}
+
+			want := data[tt.offset:]
+			if tt.sizelimit != 0 {
+				want = want[:tt.sizelimit]
+			}
+			var size uint64
+			if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), unix.BLKGETSIZE64, uintptr(unsafe.Pointer(&size))); errno != 0 {
+				t.Fatalf("BLKGETSIZE64: %v", errno)
+			}
+			if size != uint64(len(want)) {
+				t.Errorf("size = %d, want %d", size, len(want))
+			}
+			buf := make([]byte, 8192)
+			if _, err := unix.Pread(fd, buf, 0); err != nil {
+				t.Fatalf("Pread: %v", err)
+			}
+			if !bytes.Equal(buf, want[:len(buf)]) {
+				t.Error("loop device contents differ from the backing file")
+			}
+
+			if err := unix.DetachLoopDevice(fd); err != nil {
+				t.Fatalf("DetachLoopDevice: %v", err)
+			}
+			if _, err := unix.IoctlLoopGetStatus64(fd); err != unix.ENXIO {
+				t.Errorf("IoctlLoopGetStatus64 after detaching: got %v, want ENXIO", err)
+			}
+		})
+	}
+}
+
+func TestLoopSetFd(t *testing.T) {
+	f, data := loopBackingFile(t)
+	defer f.Close()
+
+	ctl, err := unix.Open("/dev/loop-control", unix.O_RDWR|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(ctl)
+	n, err := unix.IoctlRetInt(ctl, unix.LOOP_CTL_GET_FREE)
+	if err != nil {
+		t.Fatalf("LOOP_CTL_GET_FREE: %v", err)
+	}
+	fd, err := unix.Open(fmt.Sprintf("/dev/loop%d", n), unix.O_RDWR|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	// The legacy path used on kernels without LOOP_CONFIGURE.
+	info := unix.LoopInfo64{Offset: 1024, Flags: unix.LO_FLAGS_AUTOCLEAR}
+	if err := unix.LoopSetFd(fd, int(f.Fd()), &info); err != nil {
+		t.Fatalf("LoopSetFd: %v", err)
+	}
+	got, err := unix.IoctlLoopGetStatus64(fd)
+	if err != nil {
+		t.Fatalf("IoctlLoopGetStatus64: %v", err)
+	}
+	if got.Offset != info.Offset || got.Flags&unix.LO_FLAGS_AUTOCLEAR == 0 {
+		t.Errorf("status: offset %d, flags %#x; want %d with LO_FLAGS_AUTOCLEAR", got.Offset, got.Flags, info.Offset)
+	}
+	buf := make([]byte, 512)
+	if _, err := unix.Pread(fd, buf, 0); err != nil {
+		t.Fatalf("Pread: %v", err)
+	}
+	if !bytes.Equal(buf, data[1024:1536]) {
+		t.Error("loop device contents differ from the backing file")
+	}
+	if err := unix.DetachLoopDevice(fd); err != nil {
+		t.Fatalf("DetachLoopDevice: %v", err)
+	}
+}
@@ -178,9 +178,12 @@ struct ltchars {
 #include <sys/mman.h>
 #include <sys/mount.h>
 #include <sys/prctl.h>
+#include <sys/signalfd.h>
 #include <sys/stat.h>
 #include <sys/types.h>
 #include <sys/time.h>
+#include <sys/timerfd.h>
+#include <sys/timex.h>
 #include <sys/socket.h>
 #include <sys/xattr.h>
 #include <linux/if.h>
@@ -221,6 +224,18 @@ struct ltchars {
 #include <linux/hdreg.h>
 #include <linux/rtc.h>
 #include <linux/if_xdp.h>
+#include <linux/io_uring.h>
+#include <linux/aio_abi.h>
+#include <linux/audit.h>
+#include <linux/bpf.h>
+#include <linux/pidfd.h>
+#include <linux/openat2.h>
+#include <linux/fanotify.h>
+#include <linux/fiemap.h>
+#include <linux/fsverity.h>
+#include <linux/loop.h>
+#include <linux/nsfs.h>
+#include <linux/capability.h>
 #include <mtd/ubi-user.h>
 #include <net/route.h>
 #include <asm/termbits.h>
@@ -426,6 +441,7 @@ ccflags="$@"
 		$2 == "NOKERNINFO" ||
 		$2 ~ /^PAR/ ||
 		$2 ~ /^SIG[^_]/ ||
+		$2 ~ /^SIG_(BLOCK|UNBLOCK|SETMASK)$/ ||
 		$2 ~ /^O[CNPFPL][A-Z]+[^_][A-Z]+$/ ||
 		$2 ~ /^(NL|CR|TAB|BS|VT|FF)DLY$/ ||
 		$2 ~ /^(NL|CR|TAB|BS|VT|FF)[0-9]$/ ||
@@ -439,6 +455,7 @@ ccflags="$@"
 		$2 == "ICMPV6_FILTER" ||
 		$2 == "SOMAXCONN" ||
 		$2 == "NAME_MAX" ||
+		$2 == "MAX_HANDLE_SZ" ||
 		$2 == "IFNAMSIZ" ||
 		$2 ~ /^CTL_(HW|KERN|MAXNAME|NET|QUERY)$/ ||
 		$2 ~ /^KERN_(HOSTNAME|OS(RELEASE|TYPE)|VERSION)$/ ||
@@ -446,8 +463,20 @@ ccflags="$@"
 		$2 ~ /^SYSCTL_VERS/ ||
 		$2 !~ "MNT_BITS" &&
 		$2 ~ /^(MS|MNT|UMOUNT)_/ ||
+		$2 ~ /^(MOVE_MOUNT|OPEN_TREE|FSOPEN|FSPICK|FSMOUNT|MOUNT_ATTR)_/ ||
+		$2 ~ /^RESOLVE_/ ||
+		$2 ~ /^FAN(OTIFY)?_/ ||
+		$2 ~ /^(FICLONE(RANGE)?|FIDEDUPERANGE|FS_IOC_FIEMAP)$/ ||
+		$2 ~ /^(FIEMAP|FILE_DEDUPE_RANGE)_/ ||
+		$2 ~ /^FS_VERITY_/ ||
+		$2 ~ /^FS_IOC_(ENABLE|MEASURE)_VERITY$/ ||
+		$2 ~ /^LOOP_(CLR|CTL|GET|SET|CHANGE|CONFIGURE)/ && $2 !~ /_(SETTABLE|CLEARABLE)_FLAGS$/ ||
+		$2 ~ /^LO_(KEY|NAME)_SIZE$/ ||
+		$2 ~ /^NS_GET_/ ||
+		$2 ~ /^VFS_CAP_/ ||
+		$2 ~ /^XATTR_CAPS_SZ/ ||
 		$2 ~ /^TUN(SET|GET|ATTACH|DETACH)/ ||
-		$2 ~ /^(O|F|E?FD|NAME|S|PTRACE|PT)_/ ||
+		$2 ~ /^(O|F|[EST]?FD|NAME|S|PTRACE|PT)_/ ||
 		$2 ~ /^KEXEC_/ ||
 		$2 ~ /^LINUX_REBOOT_CMD_/ ||
 		$2 ~ /^LINUX_REBOOT_MAGIC[12]$/ ||
@@ -466,18 +495,26 @@ ccflags="$@"
 		$2 ~ /^RLIMIT_(AS|CORE|CPU|DATA|FSIZE|LOCKS|MEMLOCK|MSGQUEUE|NICE|NOFILE|NPROC|RSS|RTPRIO|RTTIME|SIGPENDING|STACK)|RLIM_INFINITY/ ||
 		$2 ~ /^PRIO_(PROCESS|PGRP|USER)/ ||
 		$2 ~ /^CLONE_[A-Z_]+/ ||
+		$2 ~ /^CLD_/ ||
+		$2 ~ /^PIDFD_/ ||
+		$2 ~ /^P_(ALL|PID|PGID|PIDFD)$/ ||
 		$2 !~ /^(BPF_TIMEVAL)$/ &&
 		$2 ~ /^(BPF|DLT)_/ ||
 		$2 ~ /^CLOCK_/ ||
+		$2 == "TIMER_ABSTIME" ||
+		$2 ~ /^(ADJ|STA)_/ ||
+		$2 ~ /^TIME_(OK|INS|DEL|OOP|WAIT|ERROR|BAD)$/ ||
 		$2 ~ /^CAN_/ ||
 		$2 ~ /^CAP_/ ||
 		$2 ~ /^ALG_/ ||
-		$2 ~ /^FS_(POLICY_FLAGS|KEY_DESC|ENCRYPTION_MODE|[A-Z0-9_]+_KEY_SIZE|IOC_(GET|SET)_ENCRYPTION)/ ||
+		$2 ~ /^FS_(POLICY_FLAGS|KEY_DESC|ENCRYPTION_MODE|[A-Z0-9_]+_KEY_SIZE|IOC_(GET|SET|ADD|REMOVE)_ENCRYPTION)/ ||
+		$2 ~ /^FSCRYPT_/ ||
 		$2 ~ /^GRND_/ ||
 		$2 ~ /^KEY_(SPEC|REQKEY_DEFL)_/ ||
 		$2 ~ /^KEYCTL_/ ||
 		$2 ~ /^PERF_EVENT_IOC_/ ||
-		$2 ~ /^SECCOMP_MODE_/ ||
+		$2 ~ /^SECCOMP_(MODE|SET_MODE|GET|FILTER_FLAG|RET|USER_NOTIF|ADDFD|IOCTL_NOTIF)_/ ||
+		$2 ~ /^AUDIT_ARCH_/ ||
 		$2 ~ /^SPLICE_/ ||
 		$2 ~ /^SYNC_FILE_RANGE_/ ||
 		$2 !~ /^AUDIT_RECORD_MAGIC/ &&
@@ -498,6 +535,8 @@ ccflags="$@"
 		$2 ~ /^WDIOC_/ ||
 		$2 ~ /^NFN/ ||
 		$2 ~ /^XDP_/ ||
+		$2 ~ /^(IORING|IOSQE)_/ ||
+		$2 ~ /^IOCB_FLAG_/ ||
 		$2 ~ /^(HDIO|WIN|SMART)_/ ||
 		$2 !~ "WMESGLEN" &&
 		$2 ~ /^W[A-Z0-9]+$/ ||
@@ -0,0 +1,133 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// File descriptor based mount API
+
+package unix
+
+import "unsafe"
+
+// MountSetattr changes the properties of the mount at pathname relative to
+// dirfd, or of the mount dirfd refers to if pathname is empty and flags
+// contains AT_EMPTY_PATH. With AT_RECURSIVE in flags, the change applies
+// to all mounts below it as well.
+func MountSetattr(dirfd int, pathname string, flags uint, attr *MountAttr) error {
+	return mountSetattr(dirfd, pathname, flags, attr, unsafe.Sizeof(*attr))
+}
+
+// FsconfigSetFlag sets the flag parameter key, which takes no value, on
+// the file system context fd returned by Fsopen or Fspick.
+func FsconfigSetFlag(fd int, key string) error {
+	keyp, err := BytePtrFromString(key)
+	if err != nil {
+		return err
+	}
+	return fsconfig(fd, FSCONFIG_SET_FLAG, keyp, nil, 0)
+}
+
+// FsconfigSetString sets the parameter key to value on the file system
+// context fd.
+func FsconfigSetString(fd int, key string, value string) error {
+	keyp, err := BytePtrFromString(key)
+	if err != nil {
+		return err
+	}
+	valuep, err := BytePtrFromString(value)
+	if err != nil {
+		return err
+	}
+	return fsconfig(fd, FSCONFIG_SET_STRING, keyp, valuep, 0)
+}
+
+// FsconfigSetBinary sets the parameter key to the blob value on the file
+// system context fd.
+func FsconfigSetBinary(fd int, key string, value []byte) error {
+	if len(value) == 0 {
+		return EINVAL
+	}
+	keyp, err := BytePtrFromString(key)
+	if err != nil {
+		return err
+	}
+	return fsconfig(fd, FSCONFIG_SET_BINARY, keyp, &value[0], len(value))
+}
+
+// FsconfigSetPath sets the parameter key to the object at path relative to
+// dirfd on the file system context fd, for example the source device.
+func FsconfigSetPath(fd int, key string, path string, dirfd int) error {
+	keyp, err := BytePtrFromString(key)
+	if err != nil {
+		return err
+	}
+	pathp, err := BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	return fsconfig(fd, FSCONFIG_SET_PATH, keyp, pathp, dirfd)
+}
+
+// FsconfigSetPathEmpty is like FsconfigSetPath, but path may be empty to
+// refer to dirfd itself.
+func FsconfigSetPathEmpty(fd int, key string, path string, dirfd int) error {
+	keyp, err := BytePtrFromString(key)
+	if err != nil {
+		return err
+	}
+	pathp, err := BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	return fsconfig(fd, FSCONFIG_SET_PATH_EMPTY, keyp, pathp, dirfd)
+}
+
+// FsconfigSetFd sets the parameter key to the open file value on the file
+// system context fd.
+func FsconfigSetFd(fd int, key string, value int) error {
+	keyp, err := BytePtrFromString(key)
+	if err != nil {
+		return err
+	}
+	return fsconfig(fd, FSCONFIG_SET_FD, keyp, nil, value)
+}
+
+// FsconfigCreate creates the superblock configured on the file system
+// context fd, which can then be mounted with Fsmount.
+func FsconfigCreate(fd int) error {
+	return fsconfig(fd, FSCONFIG_CMD_CREATE, nil, nil, 0)
+}
+
+// FsconfigReconfigure applies the parameters set on the file system
+// context fd, which was returned by Fspick, to its superblock.
+func FsconfigReconfigure(fd int) error {
+	return fsconfig(fd, FSCONFIG_CMD_RECONFIGURE, nil, nil, 0)
+}
+
+// BindMount bind mounts source on target, with attr applied to the new
+// mount before it becomes visible. If recursive is set, the mounts below
+// source are bind mounted as well and attr applies to all of them, which
+// mount(2) cannot express for MOUNT_ATTR_RDONLY. attr may be nil; to create
+// an idmapped mount, set MOUNT_ATTR_IDMAP in attr.Attr_set and the user
+// namespace file descriptor whose mappings to apply in attr.Userns_fd.
+func BindMount(source string, target string, recursive bool, attr *MountAttr) error {
+	flags := uint(OPEN_TREE_CLONE | OPEN_TREE_CLOEXEC)
+	if recursive {
+		flags |= AT_RECURSIVE
+	}
+	fd, err := OpenTree(AT_FDCWD, source, flags)
+	if err != nil {
+		return err
+	}
+	defer Close(fd)
+
+	if attr != nil {
+		flags := uint(AT_EMPTY_PATH)
+		if recursive {
+			flags |= AT_RECURSIVE
+		}
+		if err := MountSetattr(fd, "", flags, attr); err != nil {
+			return err
+		}
+	}
+	return MoveMount(fd, "", AT_FDCWD, target, MOVE_MOUNT_F_EMPTY_PATH)
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// inNewMountns runs f on a thread in a new private mount namespace, which
+// is discarded afterwards.
+func inNewMountns(t *testing.T, f func()) {
+	errc := make(chan error)
+	go func() {
+		runtime.LockOSThread()
+		if err := unix.Unshare(unix.CLONE_NEWNS); err != nil {
+			errc <- err
+			return
+		}
+		if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+			errc <- err
+			return
+		}
+		f()
+		errc <- nil
+	}()
+	if err := <-errc; err != nil {
+		t.Skipf("cannot create a private mount namespace: %v", err)
+	}
+}
+
+// mountTestDirs creates the directories src, with a tmpfs mounted on
+// src/sub, and dst below a new temporary directory.
+func mountTestDirs(t *testing.T) (dir, src, dst string, ok bool) {
+	dir, err := ioutil.TempDir("", "TestBindMount")
+	if err != nil {
+		t.Error(err)
+		return "", "", "", false
+	}
+	src, dst = filepath.Join(dir, "src"), filepath.Join(dir, "dst")
+	for _, d := range []string{filepath.Join(src, "sub"), dst} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Error(err)
+			return dir, "", "", false
+		}
+	}
+	if err := unix.Mount("tmpfs", filepath.Join(src, "sub"), "tmpfs", 0, ""); err != nil {
+		t.Errorf("Mount tmpfs: %v", err)
+		return dir, "", "", false
+	}
+	if err := ioutil.WriteFile(filepath.Join(src, "sub", "file"), nil, 0644); err != nil {
+		t.Error(err)
+		return dir, "", "", false
+	}
+	return dir, src, dst, true
+}
+
+func TestBindMount(t *testing.T) {
+	inNewMountns(t, func() { testBindMount(t) })
+}
+
+func testBindMount(t *testing.T) {
+	dir, src, dst, ok := mountTestDirs(t)
+	defer os.RemoveAll(dir)
+	if !ok {
+		return
+	}
+
+	attr := &unix.MountAttr{Attr_set: unix.MOUNT_ATTR_RDONLY | unix.MOUNT_ATTR_NOSUID}
+	err := unix.BindMount(src, dst, true, attr)
+	if err == unix.ENOSYS {
+		t.Log("new mount API is not available")
+		return
+	} else if err != nil {
+		t.Errorf("BindMount: %v", err)
+		return
+	}
+	defer unix.Unmount(dst, unix.MNT_DETACH)
+
+	// The statfs flags ST_RDONLY and ST_NOSUID equal MS_RDONLY and MS_NOSUID.
+	var st unix.Statfs_t
+	if err := unix.Statfs(filepath.Join(dst, "sub"), &st); err != nil {
+		t.Errorf("Statfs: %v", err)
+	} else if st.Flags&(unix.MS_RDONLY|unix.MS_NOSUID) != unix.MS_RDONLY|unix.MS_NOSUID {
+		t.Errorf("flags of the mount below the bind mount = %#x, want MS_RDONLY|MS_NOSUID", st.Flags)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dst, "sub", "file"), nil, 0644); !os.IsPermission(err) && !isErrno(err, unix.EROFS) {
+		t.Errorf("writing through a read-only bind mount: %v, want EROFS", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(src, "sub", "file"), nil, 0644); err != nil {
+		t.Errorf("writing to the source of a read-only bind mount: %v", err)
+	}
+
+	// Without recursive, the tmpfs below src is not part of the bind mount.
+	dst2 := filepath.Join(dir, "dst2")
+	if err := os.Mkdir(dst2, 0755); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := unix.BindMount(src, dst2, false, nil); err != nil {
+		t.Errorf("BindMount: %v", err)
+		return
+	}
+	defer unix.Unmount(dst2, unix.MNT_DETACH)
+	if _, err := os.Stat(filepath.Join(dst2, "sub", "file")); !os.IsNotExist(err) {
+		t.Errorf("Stat of a file below a non-recursive bind mount: %v, want ENOENT", err)
+	}
+}
+
+func isErrno(err error, errno unix.Errno) bool {
+	if pe, ok := err.(*os.PathError); ok {
+		err = pe.Err
+	}
+	return err == errno
+}
+
+func TestBindMountIdmap(t *testing.T) {
+	// A user namespace mapping UID and GID 0 to 1000.
+	cmd := exec.Command("sleep", "60")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Cloneflags: unix.CLONE_NEWUSER}
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot create a user namespace: %v", err)
+	}
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+	pid := cmd.Process.Pid
+	for _, f := range []string{"uid_map", "gid_map"} {
+		if err := ioutil.WriteFile(fmt.Sprintf("/proc/%d/%s", pid, f), []byte("0 1000 1"), 0); err != nil {
+			t.Skipf("cannot write %s: %v", f, err)
+		}
+	}
+	nsfd, err := unix.Open(fmt.Sprintf("/proc/%d/ns/user", pid), unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer unix.Close(nsfd)
+
+	inNewMountns(t, func() { testBindMountIdmap(t, nsfd) })
+}
+
+func testBindMountIdmap(t *testing.T, nsfd int) {
+	dir, src, dst, ok := mountTestDirs(t)
+	defer os.RemoveAll(dir)
+	if !ok {
+		return
+	}
+
+	attr := &unix.MountAttr{Attr_set: unix.MOUNT_ATTR_IDMAP, Userns_fd: uint64(nsfd)}
+	err := unix.BindMount(filepath.Join(src, "sub"), dst, false, attr)
+	if err == unix.ENOSYS || err == unix.EINVAL || err == unix.EPERM {
+		t.Logf("idmapped mounts are not available: %v", err)
+		return
+	} else if err != nil {
+		t.Errorf("BindMount: %v", err)
+		return
+	}
+	defer unix.Unmount(dst, unix.MNT_DETACH)
+
+	// The file created by root is owned by 1000 in the idmapped mount.
+	var st unix.Stat_t
+	if err := unix.Stat(filepath.Join(dst, "file"), &st); err != nil {
+		t.Errorf("Stat: %v", err)
+	} else if st.Uid != 1000 || st.Gid != 1000 {
+		t.Errorf("owner in the idmapped mount = %d:%d, want 1000:1000", st.Uid, st.Gid)
+	}
+}
+
+func TestFsmount(t *testing.T) {
+	inNewMountns(t, func() { testFsmount(t) })
+}
+
+func testFsmount(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestFsmount")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	fsfd, err := unix.Fsopen("tmpfs", unix.FSOPEN_CLOEXEC)
+	if err == unix.ENOSYS {
+		t.Log("new mount API is not available")
+		return
+	} else if err != nil {
+		t.Errorf("Fsopen: %v", err)
+		return
+	}
+	defer unix.Close(fsfd)
+	if err := unix.FsconfigSetString(fsfd, "size", "1m"); err != nil {
+		t.Errorf("FsconfigSetString: %v", err)
+		return
+	}
+	if err := unix.FsconfigSetString(fsfd, "nosuchoption", "1"); err != unix.EINVAL {
+		t.Errorf("FsconfigSetString of an unknown option: %v, want EINVAL", err)
+	}
+	if err := unix.FsconfigCreate(fsfd); err != nil {
+		t.Errorf("FsconfigCreate: %v", err)
+		return
+	}
+	mfd, err := unix.Fsmount(fsfd, unix.FSMOUNT_CLOEXEC, unix.MOUNT_ATTR_NOEXEC)
+	if err != nil {
+		t.Errorf("Fsmount: %v", err)
+		return
+	}
+	defer unix.Close(mfd)
+	if err := unix.MoveMount(mfd, "", unix.AT_FDCWD, dir, unix.MOVE_MOUNT_F_EMPTY_PATH); err != nil {
+		t.Errorf("MoveMount: %v", err)
+		return
+	}
+	defer unix.Unmount(dir, unix.MNT_DETACH)
+
+	var st unix.Statfs_t
+	if err := unix.Statfs(dir, &st); err != nil {
+		t.Errorf("Statfs: %v", err)
+	} else if st.Type != unix.TMPFS_MAGIC || st.Blocks*uint64(st.Bsize) != 1<<20 || st.Flags&unix.MS_NOEXEC == 0 {
+		t.Errorf("Statfs of the new mount = %+v, want a 1m noexec tmpfs", st)
+	}
+
+	// Make it read-only in place.
+	attr := &unix.MountAttr{Attr_set: unix.MOUNT_ATTR_RDONLY}
+	if err := unix.MountSetattr(unix.AT_FDCWD, dir, 0, attr); err != nil {
+		t.Errorf("MountSetattr: %v", err)
+	} else if err := unix.Mkdir(filepath.Join(dir, "x"), 0755); err != unix.EROFS {
+		t.Errorf("Mkdir on a read-only mount: %v, want EROFS", err)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Namespace file descriptors and user namespace ID maps
+
+package unix
+
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+)
+
+// IoctlNsGetUserns returns a new close-on-exec descriptor for the user
+// namespace that owns the namespace fd refers to, using NS_GET_USERNS.
+func IoctlNsGetUserns(fd int) (int, error) {
+	return IoctlRetInt(fd, NS_GET_USERNS)
+}
+
+// IoctlNsGetParent returns a new close-on-exec descriptor for the parent
+// of the PID or user namespace fd refers to, using NS_GET_PARENT. It
+// returns EPERM if the parent is outside the caller's namespace.
+func IoctlNsGetParent(fd int) (int, error) {
+	return IoctlRetInt(fd, NS_GET_PARENT)
+}
+
+// IoctlNsGetNstype returns the type of the namespace fd refers to as one
+// of the CLONE_NEW* constants, using NS_GET_NSTYPE.
+func IoctlNsGetNstype(fd int) (int, error) {
+	return IoctlRetInt(fd, NS_GET_NSTYPE)
+}
+
+// IoctlNsGetOwnerUID returns the user ID, in the caller's user namespace,
+// of the creator of the user namespace fd refers to, using
+// NS_GET_OWNER_UID.
+func IoctlNsGetOwnerUID(fd int) (uint32, error) {
+	var value uint32
+	err := ioctl(fd, NS_GET_OWNER_UID, uintptr(unsafe.Pointer(&value)))
+	return value, err
+}
+
+// nsNames maps namespace types to their files in /proc/<pid>/ns. The PID
+// and time namespaces of a thread cannot change, only those its children
+// are created in.
+var nsNames = map[int]string{
+	CLONE_NEWCGROUP: "cgroup",
+	CLONE_NEWIPC:    "ipc",
+	CLONE_NEWNET:    "net",
+	CLONE_NEWNS:     "mnt",
+	CLONE_NEWPID:    "pid_for_children",
+	CLONE_NEWTIME:   "time_for_children",
+	CLONE_NEWUSER:   "user",
+	CLONE_NEWUTS:    "uts",
+}
+
+// RunInNamespace calls f on a new OS thread that has joined the namespace
+// fd refers to, and returns the error f returns. nstype is one of the
+// CLONE_NEW* constants, or 0 to use the type of fd.
+//
+// Other goroutines and threads are unaffected. Afterwards the thread
+// rejoins its original namespace and returns to the scheduler; if that
+// fails, or the namespace is a mount namespace, for which the thread has
+// to stop sharing its root and working directory with the rest of the
+// process first, the thread is terminated instead of being reused. The
+// error from restoring the namespace is returned only if f succeeded. If f
+// calls runtime.Goexit, the thread is terminated too and RunInNamespace
+// returns an error.
+//
+// Linux does not allow a multi-threaded process to join a user namespace,
+// so the setns call fails with EINVAL for CLONE_NEWUSER.
+func RunInNamespace(fd int, nstype int, f func() error) error {
+	errc := make(chan error, 1)
+	go func() {
+		// The thread exits with the goroutine unless runInNamespace
+		// unlocks it again.
+		runtime.LockOSThread()
+		err := errNamespaceGoexit
+		defer func() { errc <- err }()
+		err = runInNamespace(fd, nstype, f)
+	}()
+	return <-errc
+}
+
+// errNamespaceGoexit is returned by RunInNamespace if f does not return.
+var errNamespaceGoexit = errors.New("unix: RunInNamespace: f called runtime.Goexit")
+
+func runInNamespace(fd int, nstype int, f func() error) error {
+	reuse := true
+	defer func() {
+		if reuse {
+			runtime.UnlockOSThread()
+		}
+	}()
+
+	if nstype == 0 {
+		var err error
+		if nstype, err = IoctlNsGetNstype(fd); err != nil {
+			return err
+		}
+	}
+	name, ok := nsNames[nstype]
+	if !ok {
+		return EINVAL
+	}
+	orig, err := Open("/proc/self/task/"+itoa(Gettid())+"/ns/"+name, O_RDONLY|O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer Close(orig)
+
+	if nstype == CLONE_NEWNS {
+		if err := Unshare(CLONE_FS); err != nil {
+			return err
+		}
+		reuse = false
+	}
+	if err := Setns(fd, nstype); err != nil {
+		return err
+	}
+	// Until the original namespace is restored, the thread must not be
+	// reused, including if f panics or calls runtime.Goexit.
+	reuse = false
+	ferr := f()
+	if err := Setns(orig, nstype); err != nil {
+		if ferr == nil {
+			ferr = err
+		}
+	} else if nstype != CLONE_NEWNS {
+		reuse = true
+	}
+	return ferr
+}
+
+// IDMap is a range of Size IDs starting at InsideID in a user namespace
+// that map to the IDs starting at OutsideID in the parent user namespace.
+type IDMap struct {
+	InsideID  uint32
+	OutsideID uint32
+	Size      uint32
+}
+
+// WriteUidMap sets the user ID mapping of the user namespace of process
+// pid, or of the calling process if pid is 0. The mapping of a namespace
+// can only be set once, and Linux limits its number of ranges.
+func WriteUidMap(pid int, m []IDMap) error {
+	return writeIDMap(pid, "uid_map", m)
+}
+
+// WriteGidMap sets the group ID mapping of the user namespace of process
+// pid, or of the calling process if pid is 0. Without the CAP_SETGID
+// capability in the parent namespace, WriteSetgroups must deny setgroups
+// in the namespace first.
+func WriteGidMap(pid int, m []IDMap) error {
+	return writeIDMap(pid, "gid_map", m)
+}
+
+// WriteSetgroups allows or denies calls to setgroups in the user namespace
+// of process pid, or of the calling process if pid is 0. It must be called
+// before the group ID mapping of the namespace is set.
+func WriteSetgroups(pid int, allow bool) error {
+	value := "deny"
+	if allow {
+		value = "allow"
+	}
+	return writeProcFile(pid, "setgroups", []byte(value))
+}
+
+func writeIDMap(pid int, name string, m []IDMap) error {
+	var buf []byte
+	for _, r := range m {
+		buf = append(buf, uitoa(uint(r.InsideID))+" "+uitoa(uint(r.OutsideID))+" "+uitoa(uint(r.Size))+"\n"...)
+	}
+	return writeProcFile(pid, name, buf)
+}
+
+// writeProcFile writes buf to the file name in the /proc directory of
+// process pid in a single call, as the files of user namespaces require.
+func writeProcFile(pid int, name string, buf []byte) error {
+	dir := "/proc/self/"
+	if pid != 0 {
+		dir = "/proc/" + itoa(pid) + "/"
+	}
+	fd, err := Open(dir+name, O_WRONLY|O_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	n, err := Write(fd, buf)
+	if err == nil && n != len(buf) {
+		err = EINVAL
+	}
+	if cerr := Close(fd); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"io/ioutil"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// nsIno returns the inode number that identifies the namespace fd.
+func nsIno(t *testing.T, fd int) uint64 {
+	var st unix.Stat_t
+	if err := unix.Fstat(fd, &st); err != nil {
+		t.Fatal(err)
+	}
+	return uint64(st.Ino)
+}
+
+// threadNsIno returns the inode number of the namespace called name of
+// the calling thread.
+func threadNsIno(name string) (uint64, error) {
+	var st unix.Stat_t
+	err := unix.Stat("/proc/self/task/"+strconv.Itoa(unix.Gettid())+"/ns/"+name, &st)
+	return uint64(st.Ino), err
+}
+
+// newNamespace returns a descriptor for a new namespace of type nstype
+// created on a thread that is terminated afterwards.
+func newNamespace(t *testing.T, nstype int, name string) int {
+	type result struct {
+		fd  int
+		err error
+	}
+	c := make(chan result, 1)
+	go func() {
+		runtime.LockOSThread()
+		if err := unix.Unshare(unix.CLONE_FS | nstype); err != nil {
+			c <- result{-1, err}
+			return
+		}
+		fd, err := unix.Open("/proc/self/task/"+strconv.Itoa(unix.Gettid())+"/ns/"+name, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+		c <- result{fd, err}
+	}()
+	r := <-c
+	if r.err == unix.EPERM {
+		t.Skipf("creating a %s namespace: %v", name, r.err)
+	}
+	if r.err != nil {
+		t.Fatalf("creating a %s namespace: %v", name, r.err)
+	}
+	return r.fd
+}
+
+func TestIoctlNsGet(t *testing.T) {
+	netns, err := unix.Open("/proc/self/ns/net", unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Skipf("namespace files not available: %v", err)
+	}
+	defer unix.Close(netns)
+	nstype, err := unix.IoctlNsGetNstype(netns)
+	if err == unix.ENOTTY {
+		t.Skip("NS_GET_NSTYPE not supported")
+	}
+	if err != nil || nstype != unix.CLONE_NEWNET {
+		t.Fatalf("IoctlNsGetNstype = %#x, %v; want CLONE_NEWNET", nstype, err)
+	}
+
+	userns, err := unix.IoctlNsGetUserns(netns)
+	if err != nil {
+		t.Fatalf("IoctlNsGetUserns: %v", err)
+	}
+	defer unix.Close(userns)
+	want, err := unix.Open("/proc/self/ns/user", unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(want)
+	if got, want := nsIno(t, userns), nsIno(t, want); got != want {
+		t.Errorf("IoctlNsGetUserns returned the namespace %d, want %d", got, want)
+	}
+	if nstype, err := unix.IoctlNsGetNstype(userns); err != nil || nstype != unix.CLONE_NEWUSER {
+		t.Errorf("IoctlNsGetNstype(userns) = %#x, %v; want CLONE_NEWUSER", nstype, err)
+	}
+	if uid, err := unix.IoctlNsGetOwnerUID(userns); err != nil {
+		t.Errorf("IoctlNsGetOwnerUID: %v", err)
+	} else {
+		t.Logf("user namespace owner %d", uid)
+	}
+	if _, err := unix.IoctlNsGetOwnerUID(netns); err != unix.EINVAL {
+		t.Errorf("IoctlNsGetOwnerUID on a network namespace = %v, want EINVAL", err)
+	}
+	if _, err := unix.IoctlNsGetParent(netns); err != unix.EINVAL {
+		t.Errorf("IoctlNsGetParent on a network namespace = %v, want EINVAL", err)
+	}
+	if parent, err := unix.IoctlNsGetParent(userns); err == nil {
+		unix.Close(parent)
+	} else if err != unix.EPERM {
+		t.Errorf("IoctlNsGetParent(userns) = %v, want a descriptor or EPERM", err)
+	}
+}
+
+func TestRunInNamespace(t *testing.T) {
+	for _, tt := range []struct {
+		nstype int
+		name   string
+	}{
+		{unix.CLONE_NEWNET, "net"},
+		{unix.CLONE_NEWUTS, "uts"},
+		{unix.CLONE_NEWNS, "mnt"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fd := newNamespace(t, tt.nstype, tt.name)
+			defer unix.Close(fd)
+			want := nsIno(t, fd)
+			orig, err := threadNsIno(tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Once with the type given and once with the type of fd.
+			for _, nstype := range []int{tt.nstype, 0} {
+				err := unix.RunInNamespace(fd, nstype, func() error {
+					got, err := threadNsIno(tt.name)
+					if err != nil {
+						return err
+					}
+					if got != want {
+						t.Errorf("f ran in the namespace %d, want %d", got, want)
+					}
+					return nil
+				})
+				if err != nil {
+					t.Fatalf("RunInNamespace(%#x): %v", nstype, err)
+				}
+			}
+			if got, err := threadNsIno(tt.name); err != nil || got != orig {
+				t.Errorf("namespace of the calling thread changed from %d to %d, %v", orig, got, err)
+			}
+		})
+	}
+
+	errTest := syscall.Errno(0x1234)
+	fd := newNamespace(t, unix.CLONE_NEWUTS, "uts")
+	defer unix.Close(fd)
+	if err := unix.RunInNamespace(fd, 0, func() error { return errTest }); err != errTest {
+		t.Errorf("RunInNamespace = %v, want the error returned by f", err)
+	}
+	if err := unix.RunInNamespace(fd, unix.CLONE_NEWNET, func() error { return nil }); err != unix.EINVAL {
+		t.Errorf("RunInNamespace with the wrong type = %v, want EINVAL", err)
+	}
+	// runtime.Goexit in f must not leave RunInNamespace blocked.
+	if err := unix.RunInNamespace(fd, 0, func() error { runtime.Goexit(); return nil }); err == nil {
+		t.Errorf("RunInNamespace with f calling runtime.Goexit succeeded")
+	}
+}
+
+func TestWriteIDMaps(t *testing.T) {
+	cmd := exec.Command("cat")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Cloneflags: syscall.CLONE_NEWUSER}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Skipf("creating a user namespace: %v", err)
+	}
+	defer cmd.Wait()
+	defer stdin.Close()
+	pid := cmd.Process.Pid
+
+	if err := unix.WriteSetgroups(pid, false); err != nil {
+		t.Fatalf("WriteSetgroups: %v", err)
+	}
+	uidMap := []unix.IDMap{{InsideID: 0, OutsideID: uint32(unix.Getuid()), Size: 1}}
+	if err := unix.WriteUidMap(pid, uidMap); err != nil {
+		t.Fatalf("WriteUidMap: %v", err)
+	}
+	gidMap := []unix.IDMap{
+		{InsideID: 0, OutsideID: uint32(unix.Getgid()), Size: 1},
+		{InsideID: 1000, OutsideID: 100000, Size: 65536},
+	}
+	if unix.Geteuid() != 0 {
+		gidMap = gidMap[:1]
+	}
+	if err := unix.WriteGidMap(pid, gidMap); err != nil {
+		t.Fatalf("WriteGidMap: %v", err)
+	}
+	// The mapping can only be written once.
+	if err := unix.WriteUidMap(pid, uidMap); err != unix.EPERM {
+		t.Errorf("second WriteUidMap = %v, want EPERM", err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		want []unix.IDMap
+	}{
+		{"uid_map", uidMap},
+		{"gid_map", gidMap},
+	} {
+		b, err := ioutil.ReadFile("/proc/" + strconv.Itoa(pid) + "/" + tt.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []unix.IDMap
+		for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+			var f [3]uint32
+			for i, s := range strings.Fields(line) {
+				v, err := strconv.ParseUint(s, 10, 32)
+				if err != nil || i >= len(f) {
+					t.Fatalf("malformed %s line %q", tt.name, line)
+				}
+				f[i] = uint32(v)
+			}
+			got = append(got, unix.IDMap{InsideID: f[0], OutsideID: f[1], Size: f[2]})
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+	if b, err := ioutil.ReadFile("/proc/" + strconv.Itoa(pid) + "/setgroups"); err != nil || strings.TrimSpace(string(b)) != "deny" {
+		t.Errorf("setgroups = %q, %v; want deny", b, err)
+	}
+}
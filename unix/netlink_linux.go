@@ -0,0 +1,368 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Netlink message encoding and decoding
+
+package unix
+
+import "encoding/binary"
+
+// nlaTypeMask masks out the NLA_F_NESTED and NLA_F_NET_BYTEORDER flags from
+// the type of a netlink attribute.
+const nlaTypeMask = ^uint16(NLA_F_NESTED | NLA_F_NET_BYTEORDER)
+
+// nlaMaxLen is the largest length of an attribute, including its header,
+// that its 16-bit length field can hold.
+const nlaMaxLen = 1<<16 - 1
+
+// netlinkRecvSize is large enough for the 32 KiB buffers the kernel uses
+// for dump replies.
+const netlinkRecvSize = 1 << 16
+
+// Netlink headers and attribute payloads use the host byte order.
+var nlByteOrder = func() binary.ByteOrder {
+	if isBigEndian {
+		return binary.BigEndian
+	}
+	return binary.LittleEndian
+}()
+
+// NlmsgAlign rounds length up to a multiple of NLMSG_ALIGNTO, like the
+// NLMSG_ALIGN macro.
+func NlmsgAlign(length int) int {
+	return (length + NLMSG_ALIGNTO - 1) &^ (NLMSG_ALIGNTO - 1)
+}
+
+// RtaAlign rounds length up to a multiple of RTA_ALIGNTO, like the
+// RTA_ALIGN macro.
+func RtaAlign(length int) int {
+	return (length + RTA_ALIGNTO - 1) &^ (RTA_ALIGNTO - 1)
+}
+
+// NlaAlign rounds length up to a multiple of NLA_ALIGNTO, like the
+// NLA_ALIGN macro.
+func NlaAlign(length int) int {
+	return (length + NLA_ALIGNTO - 1) &^ (NLA_ALIGNTO - 1)
+}
+
+// NetlinkMessage is a netlink message: a header followed by a payload
+// consisting of a family specific header and a sequence of attributes.
+type NetlinkMessage struct {
+	Header NlMsghdr
+	Data   []byte
+
+	err error // ERANGE once an attribute was too long, see Marshal
+}
+
+// NewNetlinkMessage returns a message of type typ with the given flags and
+// an empty payload.
+func NewNetlinkMessage(typ, flags uint16) *NetlinkMessage {
+	return &NetlinkMessage{Header: NlMsghdr{Type: typ, Flags: flags}}
+}
+
+func (m *NetlinkMessage) pad() {
+	for len(m.Data) < NlmsgAlign(len(m.Data)) {
+		m.Data = append(m.Data, 0)
+	}
+}
+
+// AddData appends b, padded to NLMSG_ALIGNTO, to the payload of m. It is
+// used for the family specific header, such as an IfInfomsg, that precedes
+// the attributes.
+func (m *NetlinkMessage) AddData(b []byte) {
+	m.Data = append(m.Data, b...)
+	m.pad()
+}
+
+// AddAttr appends an attribute of type typ with payload value to m. The
+// attribute uses the layout shared by struct nlattr and struct rtattr.
+// Attributes are limited to 65535 bytes including their header; a longer
+// one is left out and makes Marshal fail.
+func (m *NetlinkMessage) AddAttr(typ uint16, value []byte) {
+	if SizeofNlAttr+len(value) > nlaMaxLen {
+		m.err = ERANGE
+		return
+	}
+	var hdr [SizeofNlAttr]byte
+	nlByteOrder.PutUint16(hdr[0:2], uint16(SizeofNlAttr+len(value)))
+	nlByteOrder.PutUint16(hdr[2:4], typ)
+	m.Data = append(m.Data, hdr[:]...)
+	m.Data = append(m.Data, value...)
+	m.pad()
+}
+
+// AddAttrUint8 appends an attribute with a uint8 payload to m.
+func (m *NetlinkMessage) AddAttrUint8(typ uint16, v uint8) {
+	m.AddAttr(typ, []byte{v})
+}
+
+// AddAttrUint16 appends an attribute with a uint16 payload in host byte
+// order to m.
+func (m *NetlinkMessage) AddAttrUint16(typ uint16, v uint16) {
+	var b [2]byte
+	nlByteOrder.PutUint16(b[:], v)
+	m.AddAttr(typ, b[:])
+}
+
+// AddAttrUint32 appends an attribute with a uint32 payload in host byte
+// order to m.
+func (m *NetlinkMessage) AddAttrUint32(typ uint16, v uint32) {
+	var b [4]byte
+	nlByteOrder.PutUint32(b[:], v)
+	m.AddAttr(typ, b[:])
+}
+
+// AddAttrUint64 appends an attribute with a uint64 payload in host byte
+// order to m.
+func (m *NetlinkMessage) AddAttrUint64(typ uint16, v uint64) {
+	var b [8]byte
+	nlByteOrder.PutUint64(b[:], v)
+	m.AddAttr(typ, b[:])
+}
+
+// AddAttrString appends an attribute with a NUL-terminated string payload
+// to m.
+func (m *NetlinkMessage) AddAttrString(typ uint16, s string) {
+	b := make([]byte, len(s)+1)
+	copy(b, s)
+	m.AddAttr(typ, b)
+}
+
+// BeginNested starts a nested attribute of type typ and returns its offset
+// in the payload of m. Attributes appended until the matching call to
+// EndNested form the payload of the nested attribute.
+func (m *NetlinkMessage) BeginNested(typ uint16) int {
+	off := len(m.Data)
+	m.AddAttr(typ|NLA_F_NESTED, nil)
+	return off
+}
+
+// EndNested completes the nested attribute started at offset off by
+// BeginNested. Like other attributes, a nested attribute is limited to
+// 65535 bytes; if it is longer, Marshal fails.
+func (m *NetlinkMessage) EndNested(off int) {
+	n := len(m.Data) - off
+	if n > nlaMaxLen {
+		m.err = ERANGE
+		return
+	}
+	nlByteOrder.PutUint16(m.Data[off:off+2], uint16(n))
+}
+
+// Marshal returns the wire encoding of m. The Len field of the header is
+// set from the length of the payload. It returns ERANGE if an attribute
+// added to m, nested or not, was longer than its length field can hold.
+func (m *NetlinkMessage) Marshal() ([]byte, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.Header.Len = uint32(NLMSG_HDRLEN + len(m.Data))
+	b := make([]byte, NLMSG_HDRLEN, int(m.Header.Len))
+	nlByteOrder.PutUint32(b[0:4], m.Header.Len)
+	nlByteOrder.PutUint16(b[4:6], m.Header.Type)
+	nlByteOrder.PutUint16(b[6:8], m.Header.Flags)
+	nlByteOrder.PutUint32(b[8:12], m.Header.Seq)
+	nlByteOrder.PutUint32(b[12:16], m.Header.Pid)
+	return append(b, m.Data...), nil
+}
+
+// Err returns the error reported by an NLMSG_ERROR message, or by an
+// NLMSG_DONE message that ends a failed dump, as a syscall.Errno. It returns
+// nil for acknowledgements and for messages of any other type.
+func (m *NetlinkMessage) Err() error {
+	if m.Header.Type != NLMSG_ERROR && m.Header.Type != NLMSG_DONE {
+		return nil
+	}
+	if len(m.Data) < 4 {
+		if m.Header.Type == NLMSG_ERROR {
+			return EINVAL
+		}
+		return nil
+	}
+	if code := int32(nlByteOrder.Uint32(m.Data[0:4])); code < 0 {
+		return Errno(-code)
+	}
+	return nil
+}
+
+// Attrs parses the attributes of m that follow a family specific header of
+// hdrlen bytes, for example SizeofIfInfomsg for an RTM_NEWLINK message.
+func (m *NetlinkMessage) Attrs(hdrlen int) ([]NetlinkAttr, error) {
+	off := NlmsgAlign(hdrlen)
+	if off > len(m.Data) {
+		return nil, EINVAL
+	}
+	return ParseNetlinkAttrs(m.Data[off:])
+}
+
+// ParseNetlinkMessages parses b, the data returned by a read from a netlink
+// socket, into netlink messages. The payloads of the returned messages
+// alias b.
+func ParseNetlinkMessages(b []byte) ([]NetlinkMessage, error) {
+	var msgs []NetlinkMessage
+	for len(b) >= NLMSG_HDRLEN {
+		var m NetlinkMessage
+		m.Header.Len = nlByteOrder.Uint32(b[0:4])
+		m.Header.Type = nlByteOrder.Uint16(b[4:6])
+		m.Header.Flags = nlByteOrder.Uint16(b[6:8])
+		m.Header.Seq = nlByteOrder.Uint32(b[8:12])
+		m.Header.Pid = nlByteOrder.Uint32(b[12:16])
+		l := int(m.Header.Len)
+		if l < NLMSG_HDRLEN || l > len(b) {
+			return nil, EINVAL
+		}
+		m.Data = b[NLMSG_HDRLEN:l]
+		msgs = append(msgs, m)
+		if l = NlmsgAlign(l); l > len(b) {
+			l = len(b)
+		}
+		b = b[l:]
+	}
+	return msgs, nil
+}
+
+// NetlinkAttr is a netlink attribute. The attributes of the netlink core
+// (struct nlattr) and of rtnetlink (struct rtattr) share its layout.
+type NetlinkAttr struct {
+	// Type is the attribute type without the NLA_F_NESTED and
+	// NLA_F_NET_BYTEORDER flags, which are kept in Flags.
+	Type  uint16
+	Flags uint16
+	Value []byte
+}
+
+// ParseNetlinkAttrs parses b into a sequence of netlink attributes. The
+// values of the returned attributes alias b.
+func ParseNetlinkAttrs(b []byte) ([]NetlinkAttr, error) {
+	var attrs []NetlinkAttr
+	for len(b) >= SizeofNlAttr {
+		l := int(nlByteOrder.Uint16(b[0:2]))
+		typ := nlByteOrder.Uint16(b[2:4])
+		if l < SizeofNlAttr || l > len(b) {
+			return nil, EINVAL
+		}
+		attrs = append(attrs, NetlinkAttr{
+			Type:  typ & nlaTypeMask,
+			Flags: typ &^ nlaTypeMask,
+			Value: b[SizeofNlAttr:l],
+		})
+		if l = NlaAlign(l); l > len(b) {
+			l = len(b)
+		}
+		b = b[l:]
+	}
+	return attrs, nil
+}
+
+// Nested reports whether the NLA_F_NESTED flag is set on a.
+func (a *NetlinkAttr) Nested() bool {
+	return a.Flags&NLA_F_NESTED != 0
+}
+
+// Attrs parses the payload of the nested attribute a.
+func (a *NetlinkAttr) Attrs() ([]NetlinkAttr, error) {
+	return ParseNetlinkAttrs(a.Value)
+}
+
+// Uint8 returns the payload of a as a uint8, or 0 if it is too short.
+func (a *NetlinkAttr) Uint8() uint8 {
+	if len(a.Value) < 1 {
+		return 0
+	}
+	return a.Value[0]
+}
+
+// Uint16 returns the payload of a as a uint16 in host byte order, or 0 if
+// it is too short.
+func (a *NetlinkAttr) Uint16() uint16 {
+	if len(a.Value) < 2 {
+		return 0
+	}
+	return nlByteOrder.Uint16(a.Value)
+}
+
+// Uint32 returns the payload of a as a uint32 in host byte order, or 0 if
+// it is too short.
+func (a *NetlinkAttr) Uint32() uint32 {
+	if len(a.Value) < 4 {
+		return 0
+	}
+	return nlByteOrder.Uint32(a.Value)
+}
+
+// Uint64 returns the payload of a as a uint64 in host byte order, or 0 if
+// it is too short.
+func (a *NetlinkAttr) Uint64() uint64 {
+	if len(a.Value) < 8 {
+		return 0
+	}
+	return nlByteOrder.Uint64(a.Value)
+}
+
+// StringValue returns the payload of a as a string, with the terminating
+// NUL byte removed.
+func (a *NetlinkAttr) StringValue() string {
+	b := a.Value
+	for i, c := range b {
+		if c == 0 {
+			b = b[:i]
+			break
+		}
+	}
+	return string(b)
+}
+
+// NetlinkRequest sends m to the kernel on the netlink socket fd and returns
+// the messages of its reply that carry the sequence number of m. It reads
+// until the reply is complete: after a single message for plain requests,
+// after the NLMSG_DONE message for multipart dumps, and after the
+// acknowledgement if NLM_F_ACK is set in the flags of m. Requests that have
+// no reply must set NLM_F_ACK. An error reported by the kernel is returned
+// as a syscall.Errno.
+func NetlinkRequest(fd int, m *NetlinkMessage) ([]NetlinkMessage, error) {
+	b, err := m.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := Sendto(fd, b, 0, &SockaddrNetlink{Family: AF_NETLINK}); err != nil {
+		return nil, err
+	}
+	ack := m.Header.Flags&NLM_F_ACK != 0
+
+	var msgs []NetlinkMessage
+	buf := make([]byte, netlinkRecvSize)
+	for {
+		n, _, flags, _, err := Recvmsg(fd, buf, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		if flags&MSG_TRUNC != 0 {
+			return nil, ENOBUFS
+		}
+		b := make([]byte, n)
+		copy(b, buf)
+		replies, err := ParseNetlinkMessages(b)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range replies {
+			if r.Header.Seq != m.Header.Seq {
+				continue
+			}
+			switch r.Header.Type {
+			case NLMSG_ERROR, NLMSG_DONE:
+				if err := r.Err(); err != nil {
+					return nil, err
+				}
+				return msgs, nil
+			case NLMSG_NOOP:
+				continue
+			}
+			msgs = append(msgs, r)
+			if r.Header.Flags&NLM_F_MULTI == 0 && !ack {
+				return msgs, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestNetlinkMessageEncoding(t *testing.T) {
+	m := unix.NewNetlinkMessage(unix.RTM_NEWLINK, unix.NLM_F_REQUEST)
+	m.Header.Seq = 42
+	m.AddData([]byte{1, 2, 3})
+	m.AddAttrString(unix.IFLA_IFNAME, "lo")
+	nest := m.BeginNested(unix.IFLA_LINKINFO)
+	m.AddAttrUint32(1, 0xdeadbeef)
+	m.AddAttrUint64(2, 1<<40)
+	m.EndNested(nest)
+	m.AddAttrUint8(unix.IFLA_OPERSTATE, 6)
+
+	b, err := m.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if len(b)%unix.NLMSG_ALIGNTO != 0 || int(m.Header.Len) != len(b) {
+		t.Fatalf("marshaled %d bytes with header length %d", len(b), m.Header.Len)
+	}
+
+	// Two messages back to back, as in a read from a netlink socket.
+	msgs, err := unix.ParseNetlinkMessages(append(b, b...))
+	if err != nil {
+		t.Fatalf("ParseNetlinkMessages: %v", err)
+	}
+	if len(msgs) != 2 {
+		t.Fatalf("parsed %d messages, want 2", len(msgs))
+	}
+	got := msgs[1]
+	if got.Header != m.Header {
+		t.Errorf("header = %+v, want %+v", got.Header, m.Header)
+	}
+	attrs, err := got.Attrs(3)
+	if err != nil {
+		t.Fatalf("Attrs: %v", err)
+	}
+	if len(attrs) != 3 {
+		t.Fatalf("parsed %d attributes, want 3", len(attrs))
+	}
+	if attrs[0].Type != unix.IFLA_IFNAME || attrs[0].StringValue() != "lo" {
+		t.Errorf("attribute 0 = %d %q, want %d %q", attrs[0].Type, attrs[0].StringValue(), unix.IFLA_IFNAME, "lo")
+	}
+	if attrs[1].Type != unix.IFLA_LINKINFO || !attrs[1].Nested() {
+		t.Errorf("attribute 1 = %d, nested %v; want %d, nested", attrs[1].Type, attrs[1].Nested(), unix.IFLA_LINKINFO)
+	}
+	nested, err := attrs[1].Attrs()
+	if err != nil {
+		t.Fatalf("nested Attrs: %v", err)
+	}
+	if len(nested) != 2 || nested[0].Uint32() != 0xdeadbeef || nested[1].Uint64() != 1<<40 {
+		t.Errorf("nested attributes = %+v", nested)
+	}
+	if attrs[2].Type != unix.IFLA_OPERSTATE || attrs[2].Uint8() != 6 {
+		t.Errorf("attribute 2 = %+v", attrs[2])
+	}
+
+	if _, err := unix.ParseNetlinkMessages(b[:len(b)-1]); err != unix.EINVAL {
+		t.Errorf("ParseNetlinkMessages of a truncated message: %v, want EINVAL", err)
+	}
+}
+
+func TestNetlinkMessageTooLong(t *testing.T) {
+	m := unix.NewNetlinkMessage(unix.RTM_NEWLINK, unix.NLM_F_REQUEST)
+	m.AddAttr(unix.IFLA_IFNAME, make([]byte, 1<<16-unix.SizeofNlAttr-1))
+	if _, err := m.Marshal(); err != nil {
+		t.Fatalf("Marshal of the longest attribute: %v", err)
+	}
+	m.AddAttr(unix.IFLA_IFNAME, make([]byte, 1<<16-unix.SizeofNlAttr))
+	if _, err := m.Marshal(); err != unix.ERANGE {
+		t.Errorf("Marshal of a too long attribute: %v, want ERANGE", err)
+	}
+
+	m = unix.NewNetlinkMessage(unix.RTM_NEWLINK, unix.NLM_F_REQUEST)
+	nest := m.BeginNested(unix.IFLA_LINKINFO)
+	for i := 0; i < 2; i++ {
+		m.AddAttr(1, make([]byte, 1<<15))
+	}
+	m.EndNested(nest)
+	if _, err := m.Marshal(); err != unix.ERANGE {
+		t.Errorf("Marshal of a too long nested attribute: %v, want ERANGE", err)
+	}
+}
+
+func TestNetlinkMessageErr(t *testing.T) {
+	for _, tt := range []struct {
+		typ  uint16
+		code int32
+		want error
+	}{
+		{unix.NLMSG_ERROR, 0, nil},
+		{unix.NLMSG_ERROR, -int32(unix.ENOENT), unix.ENOENT},
+		{unix.NLMSG_DONE, 0, nil},
+		{unix.NLMSG_DONE, -int32(unix.EBUSY), unix.EBUSY},
+		{unix.RTM_NEWLINK, -1, nil},
+	} {
+		m := unix.NewNetlinkMessage(tt.typ, 0)
+		code := tt.code
+		m.AddData((*[4]byte)(unsafe.Pointer(&code))[:])
+		if err := m.Err(); err != tt.want {
+			t.Errorf("Err of type %d with code %d = %v, want %v", tt.typ, tt.code, err, tt.want)
+		}
+	}
+}
+
+func TestNetlinkRequest(t *testing.T) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_ROUTE)
+	if err != nil {
+		t.Skipf("netlink not available: %v", err)
+	}
+	defer unix.Close(fd)
+
+	var ifi unix.IfInfomsg
+	dump := unix.NewNetlinkMessage(unix.RTM_GETLINK, unix.NLM_F_REQUEST|unix.NLM_F_DUMP)
+	dump.Header.Seq = 1
+	dump.AddData((*[unix.SizeofIfInfomsg]byte)(unsafe.Pointer(&ifi))[:])
+	msgs, err := unix.NetlinkRequest(fd, dump)
+	if err != nil {
+		t.Fatalf("NetlinkRequest dump: %v", err)
+	}
+	found := false
+	for _, m := range msgs {
+		if m.Header.Type != unix.RTM_NEWLINK {
+			t.Errorf("dump returned message of type %d", m.Header.Type)
+			continue
+		}
+		attrs, err := m.Attrs(unix.SizeofIfInfomsg)
+		if err != nil {
+			t.Fatalf("Attrs: %v", err)
+		}
+		for _, a := range attrs {
+			if a.Type == unix.IFLA_IFNAME && a.StringValue() == "lo" {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Errorf("link dump of %d messages does not contain lo", len(msgs))
+	}
+
+	// A request for a link that does not exist fails with ENODEV.
+	ifi.Index = 1 << 30
+	get := unix.NewNetlinkMessage(unix.RTM_GETLINK, unix.NLM_F_REQUEST|unix.NLM_F_ACK)
+	get.Header.Seq = 2
+	get.AddData((*[unix.SizeofIfInfomsg]byte)(unsafe.Pointer(&ifi))[:])
+	if _, err := unix.NetlinkRequest(fd, get); err != unix.ENODEV {
+		t.Errorf("NetlinkRequest for a missing link: %v, want ENODEV", err)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Path resolution confined to a directory
+
+package unix
+
+import "strings"
+
+// maxSymlinks is MAXSYMLINKS, the number of symbolic links the kernel
+// follows while resolving a path before it fails with ELOOP.
+const maxSymlinks = 40
+
+// OpenBeneath opens path relative to the directory dirfd like Openat, but
+// fails with EXDEV if path is absolute or if resolving it, through ".."
+// components or symbolic links, would leave dirfd. Magic links such as
+// /proc/self/fd/N are not followed.
+//
+// OpenBeneath uses Openat2 with RESOLVE_BENEATH. On kernels without
+// openat2, it resolves path itself one component at a time, opening each
+// with O_PATH|O_NOFOLLOW and reading symbolic links with Readlinkat; the
+// final component is opened with O_NOFOLLOW added to flags, so that a
+// symbolic link swapped in concurrently fails with ELOOP.
+func OpenBeneath(dirfd int, path string, flags int, mode uint32) (fd int, err error) {
+	how := OpenHow{
+		Flags:   uint64(flags | O_LARGEFILE),
+		Resolve: RESOLVE_BENEATH | RESOLVE_NO_MAGICLINKS,
+	}
+	// openat2 rejects a mode if no file is created.
+	if flags&O_CREAT != 0 || flags&O_TMPFILE == O_TMPFILE {
+		how.Mode = uint64(mode)
+	}
+	fd, err = Openat2(dirfd, path, &how)
+	if err != ENOSYS {
+		return fd, err
+	}
+	return openBeneath(dirfd, path, flags, mode)
+}
+
+// openBeneath emulates OpenBeneath without openat2. It keeps a descriptor
+// for every directory it descended into, so that ".." returns to the
+// directory it came from instead of being resolved by the kernel, which
+// could leave dirfd if a directory was moved concurrently.
+func openBeneath(dirfd int, path string, flags int, mode uint32) (int, error) {
+	if path == "" {
+		return -1, ENOENT
+	}
+	if path[0] == '/' {
+		return -1, EXDEV
+	}
+	dirs := []int{dirfd}
+	defer func() {
+		for _, fd := range dirs[1:] {
+			Close(fd)
+		}
+	}()
+
+	// A trailing slash requires the final component to be a directory.
+	rest := path
+	if strings.HasSuffix(rest, "/") {
+		rest += "."
+	}
+	links := 0
+	for {
+		var name string
+		if i := strings.IndexByte(rest, '/'); i >= 0 {
+			name, rest = rest[:i], strings.TrimLeft(rest[i+1:], "/")
+		} else {
+			name, rest = rest, ""
+		}
+		last := rest == ""
+		switch name {
+		case "", ".":
+			if !last {
+				continue
+			}
+			name = "."
+		case "..":
+			if len(dirs) == 1 {
+				return -1, EXDEV
+			}
+			Close(dirs[len(dirs)-1])
+			dirs = dirs[:len(dirs)-1]
+			if !last {
+				continue
+			}
+			name = "."
+		}
+		dir := dirs[len(dirs)-1]
+
+		// Like the kernel, do not follow a final symbolic link with
+		// O_NOFOLLOW, and fail on it with O_CREAT|O_EXCL.
+		if last && (flags&O_NOFOLLOW != 0 || flags&(O_CREAT|O_EXCL) == O_CREAT|O_EXCL) {
+			return Openat(dir, name, flags|O_NOFOLLOW, mode)
+		}
+		fd, err := Openat(dir, name, O_PATH|O_NOFOLLOW|O_CLOEXEC, 0)
+		if last && err == ENOENT && flags&O_CREAT != 0 {
+			return Openat(dir, name, flags|O_NOFOLLOW, mode)
+		}
+		if err != nil {
+			return -1, err
+		}
+		var st Stat_t
+		if err := Fstat(fd, &st); err != nil {
+			Close(fd)
+			return -1, err
+		}
+		switch {
+		case st.Mode&S_IFMT == S_IFLNK:
+			target, err := readlinkFd(fd)
+			Close(fd)
+			if err != nil {
+				return -1, err
+			}
+			if links++; links > maxSymlinks {
+				return -1, ELOOP
+			}
+			if strings.HasPrefix(target, "/") {
+				return -1, EXDEV
+			}
+			if rest != "" {
+				target += "/" + rest
+			}
+			rest = target
+		case last:
+			Close(fd)
+			return Openat(dir, name, flags|O_NOFOLLOW, mode)
+		case st.Mode&S_IFMT == S_IFDIR:
+			dirs = append(dirs, fd)
+		default:
+			Close(fd)
+			return -1, ENOTDIR
+		}
+	}
+}
+
+// readlinkFd returns the target of the symbolic link opened with
+// O_PATH|O_NOFOLLOW as fd.
+func readlinkFd(fd int) (string, error) {
+	for n := 128; ; n *= 2 {
+		buf := make([]byte, n)
+		m, err := Readlinkat(fd, "", buf)
+		if err != nil {
+			return "", err
+		}
+		if m < n {
+			return string(buf[:m]), nil
+		}
+	}
+}
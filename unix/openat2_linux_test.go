@@ -0,0 +1,209 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// openBeneathTree creates a directory tree to resolve paths in and returns
+// a descriptor for its directory "root".
+func openBeneathTree(t *testing.T) (dir string, root int) {
+	dir, err := ioutil.TempDir("", "openat2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range []string{"root/a/b", "outside"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, f := range []string{"root/file", "root/a/b/file", "outside/file"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, f), []byte(f), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	links := map[string]string{
+		"root/a/up":       "..",
+		"root/a/loop":     "loop",
+		"root/a/escape":   "../../outside/file",
+		"root/a/abs":      filepath.Join(dir, "root/file"),
+		"root/a/bfile":    "b/file",
+		"root/a/dangling": "missing",
+	}
+	for name, target := range links {
+		if err := os.Symlink(target, filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	root, err = unix.Open(filepath.Join(dir, "root"), unix.O_PATH|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, root
+}
+
+func readFd(t *testing.T, fd int) string {
+	buf := make([]byte, 64)
+	n, err := unix.Pread(fd, buf, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(buf[:n])
+}
+
+func TestOpenat2(t *testing.T) {
+	dir, root := openBeneathTree(t)
+	defer os.RemoveAll(dir)
+	defer unix.Close(root)
+
+	how := unix.OpenHow{Flags: unix.O_RDONLY | unix.O_CLOEXEC}
+	fd, err := unix.Openat2(root, "a/bfile", &how)
+	if err == unix.ENOSYS {
+		t.Skip("openat2 not supported")
+	}
+	if err != nil {
+		t.Fatalf("Openat2: %v", err)
+	}
+	if got := readFd(t, fd); got != "root/a/b/file" {
+		t.Errorf("read %q through a/bfile", got)
+	}
+	unix.Close(fd)
+
+	tests := []struct {
+		path    string
+		resolve uint64
+		want    error
+	}{
+		{"a/escape", unix.RESOLVE_BENEATH, unix.EXDEV},
+		{"../outside/file", unix.RESOLVE_BENEATH, unix.EXDEV},
+		{"a/bfile", unix.RESOLVE_NO_SYMLINKS, unix.ELOOP},
+		{"a/up/file", unix.RESOLVE_NO_SYMLINKS, unix.ELOOP},
+		{"/proc/self/exe", unix.RESOLVE_NO_MAGICLINKS, unix.ELOOP},
+		{"/proc/self/fd/0", unix.RESOLVE_NO_MAGICLINKS, unix.ELOOP},
+		{"a/up/file", unix.RESOLVE_NO_XDEV, nil},
+		{"/proc/self/status", unix.RESOLVE_NO_XDEV, unix.EXDEV},
+		{"../../../file", unix.RESOLVE_IN_ROOT, nil},
+		{"a/abs", unix.RESOLVE_IN_ROOT, unix.ENOENT},
+	}
+	for _, tt := range tests {
+		how := unix.OpenHow{Flags: unix.O_RDONLY | unix.O_CLOEXEC, Resolve: tt.resolve}
+		fd, err := unix.Openat2(root, tt.path, &how)
+		if err != tt.want {
+			t.Errorf("Openat2(%q, resolve %#x): got error %v, want %v", tt.path, tt.resolve, err, tt.want)
+		}
+		if err == nil {
+			unix.Close(fd)
+		}
+	}
+
+	// With RESOLVE_IN_ROOT, the absolute target of a/abs resolves within
+	// root once it names a file that exists there.
+	if err := os.Remove(filepath.Join(dir, "root/a/abs")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("/a/b/file", filepath.Join(dir, "root/a/abs")); err != nil {
+		t.Fatal(err)
+	}
+	how = unix.OpenHow{Flags: unix.O_RDONLY | unix.O_CLOEXEC, Resolve: unix.RESOLVE_IN_ROOT}
+	fd, err = unix.Openat2(root, "a/abs", &how)
+	if err != nil {
+		t.Fatalf("Openat2(a/abs, RESOLVE_IN_ROOT): %v", err)
+	}
+	if got := readFd(t, fd); got != "root/a/b/file" {
+		t.Errorf("read %q through a/abs in root", got)
+	}
+	unix.Close(fd)
+
+	// openat2 rejects a mode unless a file is created.
+	how = unix.OpenHow{Flags: unix.O_RDONLY, Mode: 0644}
+	if _, err := unix.Openat2(root, "file", &how); err != unix.EINVAL {
+		t.Errorf("Openat2 with a mode and without O_CREAT: got error %v, want EINVAL", err)
+	}
+}
+
+func TestOpenBeneath(t *testing.T) {
+	impls := []struct {
+		name string
+		open func(dirfd int, path string, flags int, mode uint32) (int, error)
+	}{
+		{"OpenBeneath", unix.OpenBeneath},
+		{"userspace", unix.OpenBeneathUserspace},
+	}
+	tests := []struct {
+		path  string
+		flags int
+		want  error
+		data  string
+	}{
+		{"file", 0, nil, "root/file"},
+		{"./a//b/../../file", 0, nil, "root/file"},
+		{"a/bfile", 0, nil, "root/a/b/file"},
+		{"a/up/a/b/file", 0, nil, "root/a/b/file"},
+		{"a/up/file", 0, nil, "root/file"},
+		{"", 0, unix.ENOENT, ""},
+		{"missing", 0, unix.ENOENT, ""},
+		{"a/dangling", 0, unix.ENOENT, ""},
+		{"file/", 0, unix.ENOTDIR, ""},
+		{"file/x", 0, unix.ENOTDIR, ""},
+		{"..", 0, unix.EXDEV, ""},
+		{"a/../../outside/file", 0, unix.EXDEV, ""},
+		{"a/up/../outside/file", 0, unix.EXDEV, ""},
+		{"a/escape", 0, unix.EXDEV, ""},
+		{"a/abs", 0, unix.EXDEV, ""},
+		{"/etc/passwd", 0, unix.EXDEV, ""},
+		{"a/loop", 0, unix.ELOOP, ""},
+		{"a/bfile", unix.O_NOFOLLOW, unix.ELOOP, ""},
+	}
+	for _, impl := range impls {
+		t.Run(impl.name, func(t *testing.T) {
+			dir, root := openBeneathTree(t)
+			defer os.RemoveAll(dir)
+			defer unix.Close(root)
+
+			for _, tt := range tests {
+				fd, err := impl.open(root, tt.path, tt.flags|unix.O_RDONLY|unix.O_CLOEXEC, 0)
+				if err != tt.want {
+					t.Errorf("open %q with flags %#x: got error %v, want %v", tt.path, tt.flags, err, tt.want)
+				}
+				if err != nil {
+					continue
+				}
+				if got := readFd(t, fd); got != tt.data {
+					t.Errorf("open %q: read %q, want %q", tt.path, got, tt.data)
+				}
+				unix.Close(fd)
+			}
+
+			fd, err := impl.open(root, "a/up/a/b/", unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+			if err != nil {
+				t.Fatalf("open directory: %v", err)
+			}
+			unix.Close(fd)
+
+			// O_CREAT follows a dangling symbolic link and creates its
+			// target beneath root, unless O_EXCL is set.
+			flags := unix.O_WRONLY | unix.O_CREAT | unix.O_CLOEXEC
+			if _, err := impl.open(root, "a/dangling", flags|unix.O_EXCL, 0600); err != unix.EEXIST {
+				t.Errorf("create through a/dangling with O_EXCL: got error %v, want EEXIST", err)
+			}
+			fd, err = impl.open(root, "a/dangling", flags, 0600)
+			if err != nil {
+				t.Fatalf("create through a/dangling: %v", err)
+			}
+			unix.Close(fd)
+			if _, err := os.Stat(filepath.Join(dir, "root/a/missing")); err != nil {
+				t.Errorf("target of a/dangling not created: %v", err)
+			}
+		})
+	}
+}
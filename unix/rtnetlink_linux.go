@@ -0,0 +1,448 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// rtnetlink links, addresses and routes
+
+package unix
+
+import "unsafe"
+
+// RtnlLink is a network interface as reported by RTM_GETLINK.
+type RtnlLink struct {
+	Index        int
+	Type         uint16 // ARPHRD_*
+	Flags        uint32 // IFF_*
+	Name         string // IFLA_IFNAME
+	MTU          uint32 // IFLA_MTU
+	TxQLen       uint32 // IFLA_TXQLEN
+	HardwareAddr []byte // IFLA_ADDRESS
+	Broadcast    []byte // IFLA_BROADCAST
+	Master       int    // IFLA_MASTER, 0 if the link has no master
+	OperState    uint8  // IFLA_OPERSTATE, IF_OPER_* in linux/if.h
+	Alias        string // IFLA_IFALIAS
+}
+
+// RtnlAddr is an interface address as reported by RTM_GETADDR.
+type RtnlAddr struct {
+	Family    int
+	PrefixLen int
+	Flags     uint8 // IFA_F_*
+	Scope     uint8 // RT_SCOPE_*
+	Index     int
+	Local     []byte // IFA_LOCAL
+	Address   []byte // IFA_ADDRESS, the peer address on point-to-point links
+	Broadcast []byte // IFA_BROADCAST
+	Label     string // IFA_LABEL
+}
+
+// RtnlNexthop is one of the next hops of a multipath route.
+type RtnlNexthop struct {
+	Flags   uint8 // RTNH_F_*
+	Hops    uint8
+	Index   int
+	Gateway []byte
+}
+
+// RtnlRoute is a route as reported by RTM_GETROUTE.
+type RtnlRoute struct {
+	Family    int
+	DstLen    int
+	SrcLen    int
+	Tos       uint8
+	Table     uint32 // RT_TABLE_* or the RTA_TABLE attribute
+	Protocol  uint8  // RTPROT_*
+	Scope     uint8  // RT_SCOPE_*
+	Type      uint8  // RTN_*
+	Flags     uint32 // RTM_F_*
+	Dst       []byte // RTA_DST
+	Src       []byte // RTA_SRC
+	PrefSrc   []byte // RTA_PREFSRC
+	Gateway   []byte // RTA_GATEWAY
+	OutIndex  int    // RTA_OIF
+	Priority  uint32 // RTA_PRIORITY
+	Multipath []RtnlNexthop
+}
+
+// rtnlRequest sends m on a new NETLINK_ROUTE socket. The socket, and so the
+// request, belongs to the network namespace of the calling thread.
+func rtnlRequest(m *NetlinkMessage) ([]NetlinkMessage, error) {
+	fd, err := Socket(AF_NETLINK, SOCK_RAW|SOCK_CLOEXEC, NETLINK_ROUTE)
+	if err != nil {
+		return nil, err
+	}
+	defer Close(fd)
+	if err := Bind(fd, &SockaddrNetlink{Family: AF_NETLINK}); err != nil {
+		return nil, err
+	}
+	m.Header.Seq = 1
+	return NetlinkRequest(fd, m)
+}
+
+// rtnlDump dumps all objects of type typ, selected by the family specific
+// header hdr, and returns the messages of type want.
+func rtnlDump(typ, want uint16, hdr []byte) ([]NetlinkMessage, error) {
+	m := NewNetlinkMessage(typ, NLM_F_REQUEST|NLM_F_DUMP)
+	m.AddData(hdr)
+	msgs, err := rtnlRequest(m)
+	if err != nil {
+		return nil, err
+	}
+	n := 0
+	for _, msg := range msgs {
+		if msg.Header.Type == want {
+			msgs[n] = msg
+			n++
+		}
+	}
+	return msgs[:n], nil
+}
+
+// copyAttr returns a copy of the payload of a, so that decoded values do
+// not keep the receive buffer alive.
+func copyAttr(a *NetlinkAttr) []byte {
+	return append([]byte(nil), a.Value...)
+}
+
+// RtnlLinks returns the network interfaces of the network namespace of the
+// calling thread.
+func RtnlLinks() ([]RtnlLink, error) {
+	var ifi IfInfomsg
+	msgs, err := rtnlDump(RTM_GETLINK, RTM_NEWLINK, (*[SizeofIfInfomsg]byte)(unsafe.Pointer(&ifi))[:])
+	if err != nil {
+		return nil, err
+	}
+	links := make([]RtnlLink, 0, len(msgs))
+	for i := range msgs {
+		if len(msgs[i].Data) < SizeofIfInfomsg {
+			return nil, EINVAL
+		}
+		copy((*[SizeofIfInfomsg]byte)(unsafe.Pointer(&ifi))[:], msgs[i].Data)
+		attrs, err := msgs[i].Attrs(SizeofIfInfomsg)
+		if err != nil {
+			return nil, err
+		}
+		l := RtnlLink{
+			Index: int(ifi.Index),
+			Type:  ifi.Type,
+			Flags: ifi.Flags,
+		}
+		for j := range attrs {
+			a := &attrs[j]
+			switch a.Type {
+			case IFLA_IFNAME:
+				l.Name = a.StringValue()
+			case IFLA_MTU:
+				l.MTU = a.Uint32()
+			case IFLA_TXQLEN:
+				l.TxQLen = a.Uint32()
+			case IFLA_ADDRESS:
+				l.HardwareAddr = copyAttr(a)
+			case IFLA_BROADCAST:
+				l.Broadcast = copyAttr(a)
+			case IFLA_MASTER:
+				l.Master = int(a.Uint32())
+			case IFLA_OPERSTATE:
+				l.OperState = a.Uint8()
+			case IFLA_IFALIAS:
+				l.Alias = a.StringValue()
+			}
+		}
+		links = append(links, l)
+	}
+	return links, nil
+}
+
+// RtnlAddrs returns the interface addresses of family, or of all families
+// if family is AF_UNSPEC, in the network namespace of the calling thread.
+func RtnlAddrs(family int) ([]RtnlAddr, error) {
+	ifa := IfAddrmsg{Family: uint8(family)}
+	msgs, err := rtnlDump(RTM_GETADDR, RTM_NEWADDR, (*[SizeofIfAddrmsg]byte)(unsafe.Pointer(&ifa))[:])
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]RtnlAddr, 0, len(msgs))
+	for i := range msgs {
+		if len(msgs[i].Data) < SizeofIfAddrmsg {
+			return nil, EINVAL
+		}
+		copy((*[SizeofIfAddrmsg]byte)(unsafe.Pointer(&ifa))[:], msgs[i].Data)
+		attrs, err := msgs[i].Attrs(SizeofIfAddrmsg)
+		if err != nil {
+			return nil, err
+		}
+		a := RtnlAddr{
+			Family:    int(ifa.Family),
+			PrefixLen: int(ifa.Prefixlen),
+			Flags:     ifa.Flags,
+			Scope:     ifa.Scope,
+			Index:     int(ifa.Index),
+		}
+		for j := range attrs {
+			attr := &attrs[j]
+			switch attr.Type {
+			case IFA_LOCAL:
+				a.Local = copyAttr(attr)
+			case IFA_ADDRESS:
+				a.Address = copyAttr(attr)
+			case IFA_BROADCAST:
+				a.Broadcast = copyAttr(attr)
+			case IFA_LABEL:
+				a.Label = attr.StringValue()
+			}
+		}
+		addrs = append(addrs, a)
+	}
+	return addrs, nil
+}
+
+// addrFamily returns the address family of the IPv4 or IPv6 address ip.
+func addrFamily(ip []byte) int {
+	switch len(ip) {
+	case 4:
+		return AF_INET
+	case 16:
+		return AF_INET6
+	}
+	return AF_UNSPEC
+}
+
+func rtnlAddrMessage(typ, flags uint16, a *RtnlAddr) *NetlinkMessage {
+	local, address := a.Local, a.Address
+	if local == nil {
+		local = address
+	}
+	if address == nil {
+		address = local
+	}
+	ifa := IfAddrmsg{
+		Family:    uint8(a.Family),
+		Prefixlen: uint8(a.PrefixLen),
+		Flags:     a.Flags,
+		Scope:     a.Scope,
+		Index:     uint32(a.Index),
+	}
+	if ifa.Family == AF_UNSPEC {
+		ifa.Family = uint8(addrFamily(local))
+	}
+	m := NewNetlinkMessage(typ, NLM_F_REQUEST|NLM_F_ACK|flags)
+	m.AddData((*[SizeofIfAddrmsg]byte)(unsafe.Pointer(&ifa))[:])
+	if local != nil {
+		m.AddAttr(IFA_LOCAL, local)
+	}
+	if address != nil {
+		m.AddAttr(IFA_ADDRESS, address)
+	}
+	if a.Broadcast != nil {
+		m.AddAttr(IFA_BROADCAST, a.Broadcast)
+	}
+	if a.Label != "" {
+		m.AddAttrString(IFA_LABEL, a.Label)
+	}
+	return m
+}
+
+// RtnlAddrAdd adds the address a to the interface a.Index, like
+// "ip address add". If only one of a.Local and a.Address is set, it is used
+// for both. If a.Family is AF_UNSPEC, it is derived from the length of the
+// address.
+func RtnlAddrAdd(a *RtnlAddr) error {
+	_, err := rtnlRequest(rtnlAddrMessage(RTM_NEWADDR, NLM_F_CREATE|NLM_F_EXCL, a))
+	return err
+}
+
+// RtnlAddrDel removes the address a from the interface a.Index, like
+// "ip address del".
+func RtnlAddrDel(a *RtnlAddr) error {
+	_, err := rtnlRequest(rtnlAddrMessage(RTM_DELADDR, 0, a))
+	return err
+}
+
+// RtnlRoutes returns the routes of family, or of all families if family is
+// AF_UNSPEC, from all routing tables of the network namespace of the
+// calling thread.
+func RtnlRoutes(family int) ([]RtnlRoute, error) {
+	rtm := RtMsg{Family: uint8(family)}
+	msgs, err := rtnlDump(RTM_GETROUTE, RTM_NEWROUTE, (*[SizeofRtMsg]byte)(unsafe.Pointer(&rtm))[:])
+	if err != nil {
+		return nil, err
+	}
+	routes := make([]RtnlRoute, 0, len(msgs))
+	for i := range msgs {
+		if len(msgs[i].Data) < SizeofRtMsg {
+			return nil, EINVAL
+		}
+		copy((*[SizeofRtMsg]byte)(unsafe.Pointer(&rtm))[:], msgs[i].Data)
+		attrs, err := msgs[i].Attrs(SizeofRtMsg)
+		if err != nil {
+			return nil, err
+		}
+		r := RtnlRoute{
+			Family:   int(rtm.Family),
+			DstLen:   int(rtm.Dst_len),
+			SrcLen:   int(rtm.Src_len),
+			Tos:      rtm.Tos,
+			Table:    uint32(rtm.Table),
+			Protocol: rtm.Protocol,
+			Scope:    rtm.Scope,
+			Type:     rtm.Type,
+			Flags:    rtm.Flags,
+		}
+		for j := range attrs {
+			a := &attrs[j]
+			switch a.Type {
+			case RTA_TABLE:
+				r.Table = a.Uint32()
+			case RTA_DST:
+				r.Dst = copyAttr(a)
+			case RTA_SRC:
+				r.Src = copyAttr(a)
+			case RTA_PREFSRC:
+				r.PrefSrc = copyAttr(a)
+			case RTA_GATEWAY:
+				r.Gateway = copyAttr(a)
+			case RTA_OIF:
+				r.OutIndex = int(a.Uint32())
+			case RTA_PRIORITY:
+				r.Priority = a.Uint32()
+			case RTA_MULTIPATH:
+				if r.Multipath, err = parseRtNexthops(a.Value); err != nil {
+					return nil, err
+				}
+			}
+		}
+		routes = append(routes, r)
+	}
+	return routes, nil
+}
+
+// parseRtNexthops decodes the payload of an RTA_MULTIPATH attribute: a
+// sequence of struct rtnexthop, each followed by its own attributes.
+func parseRtNexthops(b []byte) ([]RtnlNexthop, error) {
+	var nhs []RtnlNexthop
+	for len(b) >= SizeofRtNexthop {
+		var rtnh RtNexthop
+		copy((*[SizeofRtNexthop]byte)(unsafe.Pointer(&rtnh))[:], b)
+		l := int(rtnh.Len)
+		if l < SizeofRtNexthop || l > len(b) {
+			return nil, EINVAL
+		}
+		attrs, err := ParseNetlinkAttrs(b[SizeofRtNexthop:l])
+		if err != nil {
+			return nil, err
+		}
+		nh := RtnlNexthop{Flags: rtnh.Flags, Hops: rtnh.Hops, Index: int(rtnh.Ifindex)}
+		for i := range attrs {
+			if attrs[i].Type == RTA_GATEWAY {
+				nh.Gateway = copyAttr(&attrs[i])
+			}
+		}
+		nhs = append(nhs, nh)
+		if l = RtaAlign(l); l > len(b) {
+			l = len(b)
+		}
+		b = b[l:]
+	}
+	return nhs, nil
+}
+
+// marshalRtNexthops encodes nhs as the payload of an RTA_MULTIPATH
+// attribute.
+func marshalRtNexthops(nhs []RtnlNexthop) []byte {
+	var b []byte
+	for _, nh := range nhs {
+		l := SizeofRtNexthop
+		if nh.Gateway != nil {
+			l += RtaAlign(SizeofRtAttr + len(nh.Gateway))
+		}
+		rtnh := RtNexthop{Len: uint16(l), Flags: nh.Flags, Hops: nh.Hops, Ifindex: int32(nh.Index)}
+		b = append(b, (*[SizeofRtNexthop]byte)(unsafe.Pointer(&rtnh))[:]...)
+		if nh.Gateway != nil {
+			// Reuse the attribute encoding of NetlinkMessage.
+			m := NetlinkMessage{Data: b}
+			m.AddAttr(RTA_GATEWAY, nh.Gateway)
+			b = m.Data
+		}
+	}
+	return b
+}
+
+func rtnlRouteMessage(typ, flags uint16, r *RtnlRoute) *NetlinkMessage {
+	rtm := RtMsg{
+		Family:   uint8(r.Family),
+		Dst_len:  uint8(r.DstLen),
+		Src_len:  uint8(r.SrcLen),
+		Tos:      r.Tos,
+		Protocol: r.Protocol,
+		Scope:    r.Scope,
+		Type:     r.Type,
+		Flags:    r.Flags,
+	}
+	if rtm.Family == AF_UNSPEC {
+		for _, ip := range [][]byte{r.Dst, r.Gateway, r.Src, r.PrefSrc} {
+			if f := addrFamily(ip); f != AF_UNSPEC {
+				rtm.Family = uint8(f)
+				break
+			}
+		}
+	}
+	table := r.Table
+	if table == RT_TABLE_UNSPEC {
+		table = RT_TABLE_MAIN
+	}
+	if table < 256 {
+		rtm.Table = uint8(table)
+	}
+
+	m := NewNetlinkMessage(typ, NLM_F_REQUEST|NLM_F_ACK|flags)
+	m.AddData((*[SizeofRtMsg]byte)(unsafe.Pointer(&rtm))[:])
+	if table >= 256 {
+		m.AddAttrUint32(RTA_TABLE, table)
+	}
+	if r.Dst != nil {
+		m.AddAttr(RTA_DST, r.Dst)
+	}
+	if r.Src != nil {
+		m.AddAttr(RTA_SRC, r.Src)
+	}
+	if r.PrefSrc != nil {
+		m.AddAttr(RTA_PREFSRC, r.PrefSrc)
+	}
+	if r.Gateway != nil {
+		m.AddAttr(RTA_GATEWAY, r.Gateway)
+	}
+	if r.OutIndex != 0 {
+		m.AddAttrUint32(RTA_OIF, uint32(r.OutIndex))
+	}
+	if r.Priority != 0 {
+		m.AddAttrUint32(RTA_PRIORITY, r.Priority)
+	}
+	if len(r.Multipath) > 0 {
+		m.AddAttr(RTA_MULTIPATH, marshalRtNexthops(r.Multipath))
+	}
+	return m
+}
+
+// RtnlRouteAdd adds the route r, like "ip route add". A zero r.Table
+// selects RT_TABLE_MAIN and a zero r.Type selects RTN_UNICAST. If r.Family
+// is AF_UNSPEC, it is derived from the length of the addresses in r.
+func RtnlRouteAdd(r *RtnlRoute) error {
+	rt := *r
+	if rt.Type == RTN_UNSPEC {
+		rt.Type = RTN_UNICAST
+	}
+	_, err := rtnlRequest(rtnlRouteMessage(RTM_NEWROUTE, NLM_F_CREATE|NLM_F_EXCL, &rt))
+	return err
+}
+
+// RtnlRouteDel removes the first route matching r, like "ip route del".
+// Zero values of r.Protocol, r.Type and r.Scope match routes with any
+// protocol, type and scope.
+func RtnlRouteDel(r *RtnlRoute) error {
+	rt := *r
+	if rt.Scope == RT_SCOPE_UNIVERSE {
+		rt.Scope = RT_SCOPE_NOWHERE
+	}
+	_, err := rtnlRequest(rtnlRouteMessage(RTM_DELROUTE, 0, &rt))
+	return err
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// inNewNetns runs f on a locked thread in a new network namespace. The
+// thread is not unlocked, so it exits instead of being reused. Since f does
+// not run on the test goroutine, it must not call t.Fatal.
+func inNewNetns(t *testing.T, f func()) {
+	errc := make(chan error)
+	go func() {
+		runtime.LockOSThread()
+		if err := unix.Unshare(unix.CLONE_NEWNET); err != nil {
+			errc <- err
+			return
+		}
+		f()
+		errc <- nil
+	}()
+	if err := <-errc; err != nil {
+		t.Skipf("Unshare(CLONE_NEWNET): %v", err)
+	}
+}
+
+func TestRtnetlink(t *testing.T) {
+	inNewNetns(t, func() { testRtnetlink(t) })
+}
+
+func testRtnetlink(t *testing.T) {
+	links, err := unix.RtnlLinks()
+	if err != nil {
+		t.Errorf("RtnlLinks: %v", err)
+		return
+	}
+	if len(links) != 1 || links[0].Name != "lo" || links[0].Flags&unix.IFF_LOOPBACK == 0 {
+		t.Errorf("links of a new network namespace = %+v, want only lo", links)
+		return
+	}
+	lo := links[0].Index
+
+	addr := &unix.RtnlAddr{PrefixLen: 24, Index: lo, Local: []byte{192, 0, 2, 1}}
+	if err := unix.RtnlAddrAdd(addr); err != nil {
+		t.Errorf("RtnlAddrAdd: %v", err)
+		return
+	}
+	if err := unix.RtnlAddrAdd(addr); err != unix.EEXIST {
+		t.Errorf("second RtnlAddrAdd: %v, want EEXIST", err)
+	}
+	addrs, err := unix.RtnlAddrs(unix.AF_INET)
+	if err != nil {
+		t.Errorf("RtnlAddrs: %v", err)
+		return
+	}
+	if len(addrs) != 1 || addrs[0].Index != lo || addrs[0].PrefixLen != 24 || !bytes.Equal(addrs[0].Local, addr.Local) || addrs[0].Label != "lo" {
+		t.Errorf("addresses = %+v, want %v/24 on lo", addrs, addr.Local)
+	}
+
+	route := &unix.RtnlRoute{Type: unix.RTN_BLACKHOLE, DstLen: 24, Dst: []byte{198, 51, 100, 0}, Priority: 7}
+	if err := unix.RtnlRouteAdd(route); err != nil {
+		t.Errorf("RtnlRouteAdd: %v", err)
+		return
+	}
+	findRoute := func() *unix.RtnlRoute {
+		routes, err := unix.RtnlRoutes(unix.AF_INET)
+		if err != nil {
+			t.Errorf("RtnlRoutes: %v", err)
+			return nil
+		}
+		for i := range routes {
+			if bytes.Equal(routes[i].Dst, route.Dst) {
+				return &routes[i]
+			}
+		}
+		return nil
+	}
+	r := findRoute()
+	if r == nil {
+		t.Error("added route not found")
+		return
+	}
+	if r.Type != unix.RTN_BLACKHOLE || r.DstLen != 24 || r.Table != unix.RT_TABLE_MAIN || r.Priority != 7 {
+		t.Errorf("route = %+v", r)
+	}
+	if err := unix.RtnlRouteDel(route); err != nil {
+		t.Errorf("RtnlRouteDel: %v", err)
+		return
+	}
+	if r := findRoute(); r != nil {
+		t.Errorf("route %+v still present after RtnlRouteDel", r)
+	}
+
+	if err := unix.RtnlAddrDel(addr); err != nil {
+		t.Errorf("RtnlAddrDel: %v", err)
+		return
+	}
+	if addrs, err := unix.RtnlAddrs(unix.AF_INET); err != nil || len(addrs) != 0 {
+		t.Errorf("addresses after RtnlAddrDel = %+v, %v", addrs, err)
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Seccomp filters and user notification
+
+package unix
+
+import (
+	"runtime"
+	"sort"
+	"unsafe"
+)
+
+// bpfMaxInsns is BPF_MAXINSNS, the maximum length of a classic BPF program.
+const bpfMaxInsns = 4096
+
+// x32SyscallBit is __X32_SYSCALL_BIT, which is set in the numbers of x32
+// system calls. They are reported with AUDIT_ARCH_X86_64.
+const x32SyscallBit = 0x40000000
+
+// SeccompAction is the value returned by a seccomp filter for a system
+// call: one of the SECCOMP_RET_* actions, with the SECCOMP_RET_DATA bits
+// holding the errno for SECCOMP_RET_ERRNO and the message for
+// SECCOMP_RET_TRACE.
+type SeccompAction uint32
+
+const (
+	SeccompActAllow       SeccompAction = SECCOMP_RET_ALLOW
+	SeccompActKillThread  SeccompAction = SECCOMP_RET_KILL_THREAD
+	SeccompActKillProcess SeccompAction = SECCOMP_RET_KILL_PROCESS
+	SeccompActTrap        SeccompAction = SECCOMP_RET_TRAP
+	SeccompActLog         SeccompAction = SECCOMP_RET_LOG
+	SeccompActUserNotif   SeccompAction = SECCOMP_RET_USER_NOTIF
+)
+
+// SeccompActErrno returns the action that fails a system call with errno
+// without executing it.
+func SeccompActErrno(errno Errno) SeccompAction {
+	return SECCOMP_RET_ERRNO | SeccompAction(errno)&SECCOMP_RET_DATA
+}
+
+// SeccompActTrace returns the action that notifies a tracer attached with
+// PTRACE_O_TRACESECCOMP, passing msg as the event message.
+func SeccompActTrace(msg uint16) SeccompAction {
+	return SECCOMP_RET_TRACE | SeccompAction(msg)
+}
+
+// SeccompAuditArch returns the AUDIT_ARCH_* value the kernel reports in
+// SeccompData.Arch for system calls made by the running program.
+func SeccompAuditArch() (uint32, bool) {
+	switch runtime.GOARCH {
+	case "386":
+		return AUDIT_ARCH_I386, true
+	case "amd64":
+		return AUDIT_ARCH_X86_64, true
+	case "arm":
+		return AUDIT_ARCH_ARM, true
+	case "arm64":
+		return AUDIT_ARCH_AARCH64, true
+	case "mips":
+		return AUDIT_ARCH_MIPS, true
+	case "mipsle":
+		return AUDIT_ARCH_MIPSEL, true
+	case "mips64":
+		return AUDIT_ARCH_MIPS64, true
+	case "mips64le":
+		return AUDIT_ARCH_MIPSEL64, true
+	case "ppc64":
+		return AUDIT_ARCH_PPC64, true
+	case "ppc64le":
+		return AUDIT_ARCH_PPC64LE, true
+	case "riscv64":
+		return AUDIT_ARCH_RISCV64, true
+	case "s390x":
+		return AUDIT_ARCH_S390X, true
+	}
+	return 0, false
+}
+
+// SeccompFilter builds a seccomp filter program that applies an action to
+// each system call by number. The program kills the process if a system
+// call is made with an ABI other than the one of the running program, for
+// example a 32-bit system call from a 64-bit process, as the system call
+// numbers of such ABIs differ.
+type SeccompFilter struct {
+	def   SeccompAction
+	rules map[uint32]SeccompAction
+}
+
+// NewSeccompFilter returns a filter that applies def to every system call
+// without a rule.
+func NewSeccompFilter(def SeccompAction) *SeccompFilter {
+	return &SeccompFilter{def: def, rules: make(map[uint32]SeccompAction)}
+}
+
+// AddRule sets the action for the system call with number sysno, one of
+// the SYS_* constants, replacing any previous rule for it.
+func (f *SeccompFilter) AddRule(sysno uintptr, action SeccompAction) {
+	f.rules[uint32(sysno)] = action
+}
+
+func bpfStmt(code uint16, k uint32) SockFilter {
+	return SockFilter{Code: code, K: k}
+}
+
+func bpfJump(code uint16, k uint32, jt, jf uint8) SockFilter {
+	return SockFilter{Code: code, Jt: jt, Jf: jf, K: k}
+}
+
+// Compile returns the filter as a classic BPF program operating on
+// SeccompData. It returns EINVAL if the program would exceed BPF_MAXINSNS
+// instructions and ENOSYS if seccomp is not supported on GOARCH.
+func (f *SeccompFilter) Compile() ([]SockFilter, error) {
+	arch, ok := SeccompAuditArch()
+	if !ok {
+		return nil, ENOSYS
+	}
+	var data SeccompData
+	prog := []SockFilter{
+		bpfStmt(BPF_LD|BPF_W|BPF_ABS, uint32(unsafe.Offsetof(data.Arch))),
+		bpfJump(BPF_JMP|BPF_JEQ|BPF_K, arch, 1, 0),
+		bpfStmt(BPF_RET|BPF_K, SECCOMP_RET_KILL_PROCESS),
+		bpfStmt(BPF_LD|BPF_W|BPF_ABS, uint32(unsafe.Offsetof(data.Nr))),
+	}
+	if arch == AUDIT_ARCH_X86_64 {
+		prog = append(prog,
+			bpfJump(BPF_JMP|BPF_JGE|BPF_K, x32SyscallBit, 0, 1),
+			bpfStmt(BPF_RET|BPF_K, SECCOMP_RET_KILL_PROCESS),
+		)
+	}
+
+	nrs := make([]uint32, 0, len(f.rules))
+	for nr := range f.rules {
+		nrs = append(nrs, nr)
+	}
+	sort.Slice(nrs, func(i, j int) bool { return nrs[i] < nrs[j] })
+	for _, nr := range nrs {
+		prog = append(prog,
+			bpfJump(BPF_JMP|BPF_JEQ|BPF_K, nr, 0, 1),
+			bpfStmt(BPF_RET|BPF_K, uint32(f.rules[nr])),
+		)
+	}
+	prog = append(prog, bpfStmt(BPF_RET|BPF_K, uint32(f.def)))
+
+	if len(prog) > bpfMaxInsns {
+		return nil, EINVAL
+	}
+	return prog, nil
+}
+
+// SeccompSetModeFilter installs the BPF program prog as a seccomp filter
+// with SECCOMP_SET_MODE_FILTER. The return value is the notification file
+// descriptor if SECCOMP_FILTER_FLAG_NEW_LISTENER is set in flags.
+func SeccompSetModeFilter(flags int, prog []SockFilter) (int, error) {
+	if len(prog) == 0 {
+		return -1, EINVAL
+	}
+	fprog := SockFprog{Len: uint16(len(prog)), Filter: &prog[0]}
+	return Seccomp(SECCOMP_SET_MODE_FILTER, flags, unsafe.Pointer(&fprog))
+}
+
+// Install sets PR_SET_NO_NEW_PRIVS, which is required to install a filter
+// without CAP_SYS_ADMIN, and installs the compiled filter. A filter only
+// applies to the calling thread and the threads it creates later, so a Go
+// program normally passes SECCOMP_FILTER_FLAG_TSYNC in flags to apply it to
+// all threads of the process. The return value is the notification file
+// descriptor if SECCOMP_FILTER_FLAG_NEW_LISTENER is set in flags.
+func (f *SeccompFilter) Install(flags int) (int, error) {
+	prog, err := f.Compile()
+	if err != nil {
+		return -1, err
+	}
+	// PR_SET_NO_NEW_PRIVS only applies to the calling thread, which must
+	// be the one that installs the filter.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := Prctl(PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return -1, err
+	}
+	return SeccompSetModeFilter(flags, prog)
+}
+
+// SeccompNotifRecv waits for the next notification on the listener fd
+// returned for SECCOMP_FILTER_FLAG_NEW_LISTENER and stores it in req.
+func SeccompNotifRecv(fd int, req *SeccompNotif) error {
+	// The kernel requires the structure to be zeroed.
+	*req = SeccompNotif{}
+	return ioctl(fd, SECCOMP_IOCTL_NOTIF_RECV, uintptr(unsafe.Pointer(req)))
+}
+
+// SeccompNotifSend answers the notification resp.Id on the listener fd. It
+// returns ENOENT if the target is no longer waiting for the answer, for
+// example because it was killed.
+func SeccompNotifSend(fd int, resp *SeccompNotifResp) error {
+	return ioctl(fd, SECCOMP_IOCTL_NOTIF_SEND, uintptr(unsafe.Pointer(resp)))
+}
+
+// SeccompNotifIDValid reports, by returning nil, whether the notification
+// id on the listener fd is still pending. A supervisor that reads the
+// memory of the target, for example through /proc/pid/mem, must check this
+// after the read: if the target was killed and its PID reused in the
+// meantime, the call returns ENOENT and the data read must not be used.
+func SeccompNotifIDValid(fd int, id uint64) error {
+	return ioctl(fd, SECCOMP_IOCTL_NOTIF_ID_VALID, uintptr(unsafe.Pointer(&id)))
+}
+
+// SeccompNotifAddFd installs a file descriptor of the supervisor into the
+// target of the notification addfd.Id and returns its number in the target.
+func SeccompNotifAddFd(fd int, addfd *SeccompNotifAddfd) (int, error) {
+	r, _, e := Syscall(SYS_IOCTL, uintptr(fd), SECCOMP_IOCTL_NOTIF_ADDFD, uintptr(unsafe.Pointer(addfd)))
+	if e != 0 {
+		return -1, errnoErr(e)
+	}
+	return int(r), nil
+}
+
+// SeccompNotifLoop serves the listener fd: it receives each notification,
+// passes it to handle and sends the response returned by handle, with its
+// Id field set to that of the notification. To fail the system call, set
+// Error to the negated errno; to return Val as its result, leave Error
+// zero; to let the kernel execute it, set Flags to
+// SECCOMP_USER_NOTIF_FLAG_CONTINUE.
+//
+// Notifications whose target went away before they were answered are
+// skipped. SeccompNotifLoop returns nil once no task uses the filter any
+// more, and the error otherwise. handle is called on the goroutine running
+// SeccompNotifLoop, so it must not make system calls the filter notifies
+// about if that goroutine's thread is itself subject to the filter.
+func SeccompNotifLoop(fd int, handle func(req *SeccompNotif) SeccompNotifResp) error {
+	var req SeccompNotif
+	pfd := []PollFd{{Fd: int32(fd), Events: POLLIN}}
+	for {
+		if _, err := Poll(pfd, -1); err != nil {
+			if err == EINTR {
+				continue
+			}
+			return err
+		}
+		if pfd[0].Revents&POLLIN == 0 {
+			if pfd[0].Revents&POLLHUP != 0 {
+				return nil
+			}
+			return EIO
+		}
+		if err := SeccompNotifRecv(fd, &req); err != nil {
+			if err == EINTR || err == ENOENT {
+				continue
+			}
+			return err
+		}
+		resp := handle(&req)
+		resp.Id = req.Id
+		if err := SeccompNotifSend(fd, &resp); err != nil && err != ENOENT {
+			return err
+		}
+	}
+}
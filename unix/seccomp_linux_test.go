@@ -0,0 +1,263 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestSeccompFilterCompile(t *testing.T) {
+	if _, ok := unix.SeccompAuditArch(); !ok {
+		t.Skip("seccomp not supported on this architecture")
+	}
+	f := unix.NewSeccompFilter(unix.SeccompActAllow)
+	f.AddRule(unix.SYS_MKDIRAT, unix.SeccompActErrno(unix.EXDEV))
+	f.AddRule(unix.SYS_GETPID, unix.SeccompActLog)
+	prog, err := f.Compile()
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	last := prog[len(prog)-1]
+	if last.Code != unix.BPF_RET|unix.BPF_K || last.K != unix.SECCOMP_RET_ALLOW {
+		t.Errorf("last instruction = %+v, want return of the default action", last)
+	}
+	want := map[uint32]uint32{
+		unix.SYS_MKDIRAT: unix.SECCOMP_RET_ERRNO | uint32(unix.EXDEV),
+		unix.SYS_GETPID:  unix.SECCOMP_RET_LOG,
+	}
+	for i, ins := range prog[:len(prog)-1] {
+		if ins.Code != unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K {
+			continue
+		}
+		if action, ok := want[ins.K]; ok && prog[i+1].K == action {
+			delete(want, ins.K)
+		}
+	}
+	if len(want) != 0 {
+		t.Errorf("no rules for system calls %v in %+v", want, prog)
+	}
+}
+
+// seccompSkip is printed by a child process that cannot install filters.
+const seccompSkip = "seccomp filters not supported: "
+
+func TestSeccompFilterInstall(t *testing.T) {
+	if _, ok := unix.SeccompAuditArch(); !ok {
+		t.Skip("seccomp not supported on this architecture")
+	}
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "1" {
+		if err := seccompChild(); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// The filter cannot be removed again, so install it in a child.
+	run := func(t *testing.T, start func(*exec.Cmd) error, dropAdmin bool) {
+		dir, err := ioutil.TempDir("", "TestSeccompFilterInstall")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		cmd := exec.Command(os.Args[0], "-test.run=^TestSeccompFilterInstall$", "--", fmt.Sprint(dropAdmin), dir)
+		cmd.Env = []string{"GO_WANT_HELPER_PROCESS=1"}
+		var out bytes.Buffer
+		cmd.Stdout = &out
+		cmd.Stderr = &out
+		if err := start(cmd); err != nil {
+			t.Fatal(err)
+		}
+		if err := cmd.Wait(); err != nil {
+			t.Fatalf("child process: %q, %v", out.String(), err)
+		}
+		if strings.HasPrefix(out.String(), seccompSkip) {
+			t.Skip(strings.TrimSpace(out.String()))
+		}
+	}
+
+	t.Run("default", func(t *testing.T) {
+		run(t, (*exec.Cmd).Start, false)
+	})
+
+	// Without CAP_SYS_ADMIN, the filter is only accepted on a thread with
+	// PR_SET_NO_NEW_PRIVS set.
+	t.Run("unprivileged", func(t *testing.T) {
+		caps, err := unix.GetCapabilities(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !caps.Effective.IsMember(unix.CAP_SYS_ADMIN) {
+			run(t, (*exec.Cmd).Start, true)
+			return
+		}
+		if !caps.Effective.IsMember(unix.CAP_SETPCAP) {
+			t.Skip("dropping CAP_SYS_ADMIN requires the CAP_SETPCAP capability")
+		}
+		// A thread without CAP_SYS_ADMIN in its bounding set starts the
+		// child, which therefore does not get it. The thread exits with
+		// the goroutine.
+		errc := make(chan error, 1)
+		start := func(cmd *exec.Cmd) error {
+			go func() {
+				runtime.LockOSThread()
+				if err := unix.CapBoundingSetDrop(unix.CAP_SYS_ADMIN); err != nil {
+					errc <- err
+					return
+				}
+				errc <- cmd.Start()
+			}()
+			return <-errc
+		}
+		run(t, start, true)
+	})
+}
+
+func seccompChild() error {
+	dropAdmin, dir := os.Args[len(os.Args)-2] == "true", os.Args[len(os.Args)-1]
+	if dropAdmin {
+		caps, err := unix.GetCapabilities(0)
+		if err != nil {
+			return err
+		}
+		if caps.Effective.IsMember(unix.CAP_SYS_ADMIN) {
+			return fmt.Errorf("child has CAP_SYS_ADMIN")
+		}
+	}
+	f := unix.NewSeccompFilter(unix.SeccompActAllow)
+	f.AddRule(unix.SYS_MKDIRAT, unix.SeccompActErrno(unix.EXDEV))
+	if _, err := f.Install(unix.SECCOMP_FILTER_FLAG_TSYNC); err != nil {
+		if err == unix.EINVAL || err == unix.ENOSYS {
+			fmt.Print(seccompSkip, err)
+			return nil
+		}
+		return fmt.Errorf("Install: %v", err)
+	}
+	if err := unix.Mkdir(filepath.Join(dir, "denied"), 0700); err != unix.EXDEV {
+		return fmt.Errorf("Mkdir with a filter installed: %v, want EXDEV", err)
+	}
+	// Other threads of the process are covered by SECCOMP_FILTER_FLAG_TSYNC.
+	errc := make(chan error)
+	go func() { errc <- os.Mkdir(filepath.Join(dir, "thread"), 0700) }()
+	if err := <-errc; err == nil {
+		return fmt.Errorf("Mkdir in another goroutine succeeded")
+	}
+	return nil
+}
+
+func TestSeccompNotifLoop(t *testing.T) {
+	if _, ok := unix.SeccompAuditArch(); !ok {
+		t.Skip("seccomp not supported on this architecture")
+	}
+	if os.Getenv("GO_WANT_HELPER_PROCESS") == "1" {
+		if err := seccompNotifChild(); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	dir, err := ioutil.TempDir("", "TestSeccompNotifLoop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestSeccompNotifLoop$", "--", dir)
+	cmd.Env = []string{"GO_WANT_HELPER_PROCESS=1"}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("child process: %q, %v", out, err)
+	}
+}
+
+// seccompNotifPath reads the path argument of the mkdirat call req from
+// the memory of the target.
+func seccompNotifPath(fd int, req *unix.SeccompNotif) (string, error) {
+	mem, err := unix.Open(fmt.Sprintf("/proc/%d/mem", req.Pid), unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return "", err
+	}
+	defer unix.Close(mem)
+	buf := make([]byte, unix.PathMax)
+	n, err := unix.Pread(mem, buf, int64(req.Data.Args[1]))
+	if err != nil {
+		return "", err
+	}
+	// The target may have been replaced while its memory was read.
+	if err := unix.SeccompNotifIDValid(fd, req.Id); err != nil {
+		return "", err
+	}
+	buf = buf[:n]
+	for i, c := range buf {
+		if c == 0 {
+			buf = buf[:i]
+			break
+		}
+	}
+	return string(buf), nil
+}
+
+func seccompNotifChild() error {
+	dir := os.Args[len(os.Args)-1]
+
+	// Without SECCOMP_FILTER_FLAG_TSYNC, the filter applies to this thread
+	// only, so the supervisor below is not subject to it.
+	runtime.LockOSThread()
+	f := unix.NewSeccompFilter(unix.SeccompActAllow)
+	f.AddRule(unix.SYS_MKDIRAT, unix.SeccompActUserNotif)
+	fd, err := f.Install(unix.SECCOMP_FILTER_FLAG_NEW_LISTENER)
+	if err == unix.EINVAL || err == unix.ENOSYS {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Install: %v", err)
+	}
+
+	go unix.SeccompNotifLoop(fd, func(req *unix.SeccompNotif) unix.SeccompNotifResp {
+		path, err := seccompNotifPath(fd, req)
+		if err != nil {
+			return unix.SeccompNotifResp{Error: -int32(unix.EIO)}
+		}
+		switch filepath.Base(path) {
+		case "emulated":
+			return unix.SeccompNotifResp{Val: 0}
+		case "denied":
+			return unix.SeccompNotifResp{Error: -int32(unix.EXDEV)}
+		}
+		return unix.SeccompNotifResp{Flags: unix.SECCOMP_USER_NOTIF_FLAG_CONTINUE}
+	})
+
+	for _, tt := range []struct {
+		name   string
+		err    error
+		exists bool
+	}{
+		{"emulated", nil, false},
+		{"denied", unix.EXDEV, false},
+		{"real", nil, true},
+	} {
+		path := filepath.Join(dir, tt.name)
+		if err := unix.Mkdir(path, 0700); err != tt.err {
+			return fmt.Errorf("Mkdir %s: %v, want %v", tt.name, err, tt.err)
+		}
+		if _, err := os.Stat(path); (err == nil) != tt.exists {
+			return fmt.Errorf("Stat %s after Mkdir: %v", tt.name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Signal sets and signalfd records
+
+package unix
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+const (
+	// sigsetWordBits is the number of signals in each element of
+	// Sigset_t.Val, which is a uint32 or a uint64 depending on GOARCH.
+	sigsetWordBits = 8 * int(unsafe.Sizeof(Sigset_t{}.Val[0]))
+
+	// sigsetSignals is the number of signals the kernel supports, which
+	// is the number of bits of Sigset_t it reads.
+	sigsetSignals = sizeofKernelSigset * 8
+)
+
+// sigsetBit returns the index of sig in a Sigset_t, or -1 if sig is not a
+// valid signal number.
+func sigsetBit(sig syscall.Signal) int {
+	if sig < 1 || int(sig) > sigsetSignals {
+		return -1
+	}
+	return int(sig) - 1
+}
+
+// Add adds sig to the set. Invalid signal numbers are ignored.
+func (s *Sigset_t) Add(sig syscall.Signal) {
+	if n := sigsetBit(sig); n >= 0 {
+		s.Val[n/sigsetWordBits] |= 1 << uint(n%sigsetWordBits)
+	}
+}
+
+// Del removes sig from the set. Invalid signal numbers are ignored.
+func (s *Sigset_t) Del(sig syscall.Signal) {
+	if n := sigsetBit(sig); n >= 0 {
+		s.Val[n/sigsetWordBits] &^= 1 << uint(n%sigsetWordBits)
+	}
+}
+
+// IsMember reports whether sig is in the set.
+func (s *Sigset_t) IsMember(sig syscall.Signal) bool {
+	n := sigsetBit(sig)
+	return n >= 0 && s.Val[n/sigsetWordBits]&(1<<uint(n%sigsetWordBits)) != 0
+}
+
+// Fill adds all signals to the set.
+func (s *Sigset_t) Fill() {
+	for sig := syscall.Signal(1); sig <= sigsetSignals; sig++ {
+		s.Add(sig)
+	}
+}
+
+// Clear removes all signals from the set.
+func (s *Sigset_t) Clear() {
+	*s = Sigset_t{}
+}
+
+// ParseSignalfdSiginfo parses buf, which holds records read from a
+// signalfd, into infos. It returns EINVAL if buf ends with a partial
+// record, together with the complete records before it.
+func ParseSignalfdSiginfo(buf []byte) ([]SignalfdSiginfo, error) {
+	infos := make([]SignalfdSiginfo, len(buf)/SizeofSignalfdSiginfo)
+	for i := range infos {
+		copy((*[SizeofSignalfdSiginfo]byte)(unsafe.Pointer(&infos[i]))[:], buf[i*SizeofSignalfdSiginfo:])
+	}
+	if len(buf)%SizeofSignalfdSiginfo != 0 {
+		return infos, EINVAL
+	}
+	return infos, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"runtime"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestSigset(t *testing.T) {
+	var s unix.Sigset_t
+	for _, sig := range []syscall.Signal{unix.SIGINT, unix.SIGUSR1, 64} {
+		if s.IsMember(sig) {
+			t.Errorf("empty set has %v", sig)
+		}
+		s.Add(sig)
+		if !s.IsMember(sig) {
+			t.Errorf("set does not have %v after Add", sig)
+		}
+	}
+	s.Del(unix.SIGINT)
+	if s.IsMember(unix.SIGINT) || !s.IsMember(unix.SIGUSR1) {
+		t.Errorf("Del(SIGINT) removed the wrong signal: %v", s.Val)
+	}
+
+	// Invalid signals are ignored.
+	before := s
+	s.Add(0)
+	s.Add(1000)
+	if s != before || s.IsMember(0) || s.IsMember(1000) {
+		t.Errorf("invalid signals changed the set: %v", s.Val)
+	}
+
+	s.Clear()
+	if s != (unix.Sigset_t{}) {
+		t.Errorf("Clear left %v", s.Val)
+	}
+	s.Fill()
+	for sig := syscall.Signal(1); sig <= 64; sig++ {
+		if !s.IsMember(sig) {
+			t.Errorf("filled set does not have %v", sig)
+		}
+	}
+}
+
+func TestSignalfd(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var set, old unix.Sigset_t
+	set.Add(unix.SIGUSR1)
+	if err := unix.PthreadSigmask(unix.SIG_BLOCK, &set, &old); err != nil {
+		t.Fatalf("PthreadSigmask: %v", err)
+	}
+	defer unix.PthreadSigmask(unix.SIG_SETMASK, &old, nil)
+	var cur unix.Sigset_t
+	if err := unix.PthreadSigmask(unix.SIG_BLOCK, nil, &cur); err != nil {
+		t.Fatalf("PthreadSigmask: %v", err)
+	}
+	if !cur.IsMember(unix.SIGUSR1) {
+		t.Fatal("SIGUSR1 is not blocked")
+	}
+
+	fd, err := unix.Signalfd(-1, &set, unix.SFD_CLOEXEC|unix.SFD_NONBLOCK)
+	if err != nil {
+		t.Fatalf("Signalfd: %v", err)
+	}
+	defer unix.Close(fd)
+
+	epfd, err := unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(epfd)
+	if err := unix.EpollCtl(epfd, unix.EPOLL_CTL_ADD, fd, &unix.EpollEvent{Events: unix.EPOLLIN, Fd: int32(fd)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := unix.Tgkill(unix.Getpid(), unix.Gettid(), unix.SIGUSR1); err != nil {
+		t.Fatalf("Tgkill: %v", err)
+	}
+	events := make([]unix.EpollEvent, 1)
+	n, err := unix.EpollWait(epfd, events, 5000)
+	if err != nil || n != 1 || events[0].Fd != int32(fd) {
+		t.Fatalf("EpollWait = %d, %v, %+v; want the signalfd to be ready", n, err, events[:n])
+	}
+
+	buf := make([]byte, 4*unix.SizeofSignalfdSiginfo)
+	n, err = unix.Read(fd, buf)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	infos, err := unix.ParseSignalfdSiginfo(buf[:n])
+	if err != nil {
+		t.Fatalf("ParseSignalfdSiginfo: %v", err)
+	}
+	if len(infos) != 1 {
+		t.Fatalf("got %d signals, want 1", len(infos))
+	}
+	info := infos[0]
+	if syscall.Signal(info.Signo) != unix.SIGUSR1 || int(info.Pid) != unix.Getpid() || int(info.Uid) != unix.Getuid() {
+		t.Errorf("got signal %d from pid %d uid %d, want %d from %d uid %d",
+			info.Signo, info.Pid, info.Uid, unix.SIGUSR1, unix.Getpid(), unix.Getuid())
+	}
+}
+
+func TestParseSignalfdSiginfo(t *testing.T) {
+	var want unix.SignalfdSiginfo
+	want.Signo = uint32(unix.SIGTERM)
+	want.Pid = 42
+	raw := (*[unix.SizeofSignalfdSiginfo]byte)(unsafe.Pointer(&want))[:]
+
+	// Records need not be aligned in buf.
+	buf := append([]byte{0}, raw...)
+	buf = append(buf, raw...)
+	infos, err := unix.ParseSignalfdSiginfo(buf[1:])
+	if err != nil || len(infos) != 2 || infos[0] != want || infos[1] != want {
+		t.Errorf("ParseSignalfdSiginfo = %+v, %v; want two copies of %+v", infos, err, want)
+	}
+	infos, err = unix.ParseSignalfdSiginfo(buf[1 : len(buf)-1])
+	if err != unix.EINVAL || len(infos) != 1 {
+		t.Errorf("ParseSignalfdSiginfo of a partial record = %d records, %v; want 1, EINVAL", len(infos), err)
+	}
+}
@@ -53,6 +53,7 @@ func Fchmodat(dirfd int, path string, mode uint32, flags int) (err error) {
 }
 
 //sys	ioctl(fd int, req uint, arg uintptr) (err error)
+//sys	ioctlRet(fd int, req uint, arg uintptr) (ret int, err error) = SYS_IOCTL
 
 // ioctl itself should not be exposed directly, but additional get/set
 // functions for specific types are permissible.
@@ -88,6 +89,13 @@ func IoctlGetInt(fd int, req uint) (int, error) {
 	return value, err
 }
 
+// IoctlRetInt performs an ioctl operation specified by req on a device
+// associated with opened file descriptor fd, and returns a non-negative
+// integer that is returned by the ioctl syscall.
+func IoctlRetInt(fd int, req uint) (int, error) {
+	return ioctlRet(fd, req, 0)
+}
+
 func IoctlGetWinsize(fd int, req uint) (*Winsize, error) {
 	var value Winsize
 	err := ioctl(fd, req, uintptr(unsafe.Pointer(&value)))
@@ -124,6 +132,22 @@ func Openat(dirfd int, path string, flags int, mode uint32) (fd int, err error)
 	return openat(dirfd, path, flags|O_LARGEFILE, mode)
 }
 
+//sys	openat2(dirfd int, path string, how *OpenHow, size int) (fd int, err error)
+
+// Openat2 opens path relative to the directory dirfd as described by how,
+// using the openat2 system call. Unlike Openat, it does not add
+// O_LARGEFILE to how.Flags, and how.Mode must be 0 unless a file is
+// created. The size of how passed to the kernel is SizeofOpenHow; kernels
+// that do not know the later fields of OpenHow fail with E2BIG if they are
+// not zero.
+//
+// Openat2 does not fall back to openat: on kernels before Linux 5.6 it
+// returns ENOSYS. OpenBeneath falls back only on ENOSYS too, so an EPERM
+// returned by a seccomp filter that rejects openat2 is passed on as is.
+func Openat2(dirfd int, path string, how *OpenHow) (fd int, err error) {
+	return openat2(dirfd, path, how, SizeofOpenHow)
+}
+
 //sys	ppoll(fds *PollFd, nfds int, timeout *Timespec, sigmask *Sigset_t) (n int, err error)
 
 func Ppoll(fds []PollFd, timeout *Timespec, sigmask *Sigset_t) (n int, err error) {
@@ -1370,10 +1394,16 @@ func Mount(source string, target string, fstype string, flags uintptr, data stri
 //sys	Acct(path string) (err error)
 //sys	AddKey(keyType string, description string, payload []byte, ringid int) (id int, err error)
 //sys	Adjtimex(buf *Timex) (state int, err error)
+//sys	Bpf(cmd int, attr unsafe.Pointer, size uintptr) (ret int, err error)
+//sys	Capget(hdr *CapUserHeader, data *CapUserData) (err error)
+//sys	Capset(hdr *CapUserHeader, data *CapUserData) (err error)
 //sys	Chdir(path string) (err error)
 //sys	Chroot(path string) (err error)
+//sys	ClockAdjtime(clockid int32, buf *Timex) (state int, err error)
 //sys	ClockGetres(clockid int32, res *Timespec) (err error)
 //sys	ClockGettime(clockid int32, time *Timespec) (err error)
+//sys	ClockNanosleep(clockid int32, flags int, request *Timespec, remain *Timespec) (err error)
+//sys	ClockSettime(clockid int32, time *Timespec) (err error)
 //sys	Close(fd int) (err error)
 //sys	CopyFileRange(rfd int, roff *int64, wfd int, woff *int64, len int, flags int) (n int, err error)
 //sys	DeleteModule(name string, flags int) (err error)
@@ -1384,6 +1414,8 @@ func Mount(source string, target string, fstype string, flags uintptr, data stri
 //sys	Eventfd(initval uint, flags int) (fd int, err error) = SYS_EVENTFD2
 //sys	Exit(code int) = SYS_EXIT_GROUP
 //sys	Fallocate(fd int, mode uint32, off int64, len int64) (err error)
+//sys	FanotifyInit(flags uint, eventFFlags uint) (fd int, err error)
+//sys	fanotifyMark(fd int, flags uint, mask int64, dirfd int, pathname *byte) (err error)
 //sys	Fchdir(fd int) (err error)
 //sys	Fchmod(fd int, mode uint32) (err error)
 //sys	Fchownat(dirfd int, path string, uid int, gid int, flags int) (err error)
@@ -1394,7 +1426,11 @@ func Mount(source string, target string, fstype string, flags uintptr, data stri
 //sys	Flistxattr(fd int, dest []byte) (sz int, err error)
 //sys	Flock(fd int, how int) (err error)
 //sys	Fremovexattr(fd int, attr string) (err error)
+//sys	fsconfig(fd int, cmd uint, key *byte, value *byte, aux int) (err error)
 //sys	Fsetxattr(fd int, attr string, dest []byte, flags int) (err error)
+//sys	Fsmount(fd int, flags int, mountAttrs int) (fsfd int, err error)
+//sys	Fsopen(fsName string, flags int) (fd int, err error)
+//sys	Fspick(dirfd int, pathName string, flags int) (fd int, err error)
 //sys	Fsync(fd int) (err error)
 //sys	Getdents(fd int, buf []byte) (n int, err error) = SYS_GETDENTS64
 //sysnb	Getpgid(pid int) (pgid int, err error)
@@ -1416,6 +1452,52 @@ func Getpgrp() (pid int) {
 //sys	InotifyAddWatch(fd int, pathname string, mask uint32) (watchdesc int, err error)
 //sysnb	InotifyInit1(flags int) (fd int, err error)
 //sysnb	InotifyRmWatch(fd int, watchdesc uint32) (success int, err error)
+//sys	IoCancel(ctx uintptr, iocb *Iocb, result *IoEvent) (err error)
+//sys	IoDestroy(ctx uintptr) (err error)
+//sys	ioGetevents(ctx uintptr, minNr int, nr int, events *IoEvent, timeout *Timespec) (n int, err error)
+
+// IoGetevents waits for at least minNr and at most len(events) completions
+// on the AIO context ctx. A nil timeout waits indefinitely.
+func IoGetevents(ctx uintptr, minNr int, events []IoEvent, timeout *Timespec) (n int, err error) {
+	if len(events) == 0 {
+		return ioGetevents(ctx, minNr, 0, nil, timeout)
+	}
+	return ioGetevents(ctx, minNr, len(events), &events[0], timeout)
+}
+
+//sys	IoSetup(nrEvents int, ctxp *uintptr) (err error)
+//sys	ioSubmit(ctx uintptr, nr int, iocbpp **Iocb) (n int, err error)
+
+// IoSubmit queues the I/O control blocks in iocbs on the AIO context ctx
+// and returns the number of blocks submitted, which may be less than
+// len(iocbs).
+func IoSubmit(ctx uintptr, iocbs []*Iocb) (n int, err error) {
+	if len(iocbs) == 0 {
+		return 0, nil
+	}
+	return ioSubmit(ctx, len(iocbs), &iocbs[0])
+}
+
+//sys	IoUringSetup(entries uint32, params *IoUringParams) (fd int, err error)
+
+// sizeofKernelSigset is the size of the kernel's sigset_t, which system
+// calls taking a signal mask require as its size. It is smaller than
+// Sigset_t, which has the size of the C library's sigset_t. The kernel's
+// _NSIG is a multiple of 64, and one less than the C library's _NSIG
+// except on mips.
+const sizeofKernelSigset = (_C__NSIG - 1 + 63) / 64 * 8
+
+//sys	ioUringEnter(fd int, toSubmit uint32, minComplete uint32, flags uint32, sigset *Sigset_t, sigsetsize uintptr) (n int, err error)
+
+// IoUringEnter submits up to toSubmit entries from the submission queue of
+// the io_uring instance fd and, if IORING_ENTER_GETEVENTS is set in flags,
+// waits for at least minComplete completions. If sigset is not nil, it
+// replaces the signal mask for the duration of the call.
+func IoUringEnter(fd int, toSubmit uint32, minComplete uint32, flags uint32, sigset *Sigset_t) (n int, err error) {
+	return ioUringEnter(fd, toSubmit, minComplete, flags, sigset, sizeofKernelSigset)
+}
+
+//sys	IoUringRegister(fd int, opcode uint32, arg unsafe.Pointer, nrArgs uint32) (ret int, err error)
 //sysnb	Kill(pid int, sig syscall.Signal) (err error)
 //sys	Klogctl(typ int, buf []byte) (n int, err error) = SYS_SYSLOG
 //sys	Lgetxattr(path string, attr string, dest []byte) (sz int, err error)
@@ -1426,17 +1508,27 @@ func Getpgrp() (pid int) {
 //sys	MemfdCreate(name string, flags int) (fd int, err error)
 //sys	Mkdirat(dirfd int, path string, mode uint32) (err error)
 //sys	Mknodat(dirfd int, path string, mode uint32, dev int) (err error)
+//sys	mountSetattr(dirfd int, pathname string, flags uint, attr *MountAttr, size uintptr) (err error) = SYS_MOUNT_SETATTR
+//sys	MoveMount(fromDirfd int, fromPathName string, toDirfd int, toPathName string, flags int) (err error)
+//sys	nameToHandleAt(dirfd int, path string, fh *fileHandle, mountID *_C_int, flags int) (err error) = SYS_NAME_TO_HANDLE_AT
 //sys	Nanosleep(time *Timespec, leftover *Timespec) (err error)
+//sys	openByHandleAt(mountFD int, fh *fileHandle, flags int) (fd int, err error) = SYS_OPEN_BY_HANDLE_AT
+//sys	OpenTree(dfd int, fileName string, flags uint) (r int, err error)
 //sys	PerfEventOpen(attr *PerfEventAttr, pid int, cpu int, groupFd int, flags int) (fd int, err error)
+//sys	PidfdGetfd(pidfd int, targetfd int, flags int) (fd int, err error) = SYS_PIDFD_GETFD
+//sys	PidfdOpen(pid int, flags int) (fd int, err error) = SYS_PIDFD_OPEN
+//sys	PidfdSendSignal(pidfd int, sig syscall.Signal, info *Siginfo, flags int) (err error) = SYS_PIDFD_SEND_SIGNAL
 //sys	PivotRoot(newroot string, putold string) (err error) = SYS_PIVOT_ROOT
 //sysnb prlimit(pid int, resource int, newlimit *Rlimit, old *Rlimit) (err error) = SYS_PRLIMIT64
 //sys   Prctl(option int, arg2 uintptr, arg3 uintptr, arg4 uintptr, arg5 uintptr) (err error)
+//sys	prctlRet(option int, arg2 uintptr, arg3 uintptr, arg4 uintptr, arg5 uintptr) (ret int, err error) = SYS_PRCTL
 //sys	Pselect(nfd int, r *FdSet, w *FdSet, e *FdSet, timeout *Timespec, sigmask *Sigset_t) (n int, err error) = SYS_PSELECT6
 //sys	read(fd int, p []byte) (n int, err error)
 //sys	Removexattr(path string, attr string) (err error)
 //sys	Renameat(olddirfd int, oldpath string, newdirfd int, newpath string) (err error)
 //sys	Renameat2(olddirfd int, oldpath string, newdirfd int, newpath string, flags uint) (err error)
 //sys	RequestKey(keyType string, description string, callback string, destRingid int) (id int, err error)
+//sys	Seccomp(op int, flags int, uargs unsafe.Pointer) (ret int, err error)
 //sys	Setdomainname(p []byte) (err error)
 //sys	Sethostname(p []byte) (err error)
 //sysnb	Setpgid(pid int, pgid int) (err error)
@@ -1465,16 +1557,67 @@ func Setgid(uid int) (err error) {
 //sysnb	Sysinfo(info *Sysinfo_t) (err error)
 //sys	Tee(rfd int, wfd int, len int, flags int) (n int64, err error)
 //sysnb	Tgkill(tgid int, tid int, sig syscall.Signal) (err error)
+//sys	TimerDelete(timerid int) (err error)
+//sys	TimerGetoverrun(timerid int) (count int, err error)
+//sys	TimerGettime(timerid int, currValue *Itimerspec) (err error)
+//sys	TimerSettime(timerid int, flags int, newValue *Itimerspec, oldValue *Itimerspec) (err error)
+//sys	TimerfdCreate(clockid int, flags int) (fd int, err error)
+//sys	TimerfdGettime(fd int, currValue *Itimerspec) (err error)
+//sys	TimerfdSettime(fd int, flags int, newValue *Itimerspec, oldValue *Itimerspec) (err error)
 //sysnb	Times(tms *Tms) (ticks uintptr, err error)
 //sysnb	Umask(mask int) (oldmask int)
 //sysnb	Uname(buf *Utsname) (err error)
 //sys	Unmount(target string, flags int) (err error) = SYS_UMOUNT2
 //sys	Unshare(flags int) (err error)
+//sys	Waitid(idType int, id int, info *Siginfo, options int, rusage *Rusage) (err error)
 //sys	write(fd int, p []byte) (n int, err error)
 //sys	exitThread(code int) (err error) = SYS_EXIT
 //sys	readlen(fd int, p *byte, np int) (n int, err error) = SYS_READ
 //sys	writelen(fd int, p *byte, np int) (n int, err error) = SYS_WRITE
 
+//sys	signalfd(fd int, sigmask *Sigset_t, maskSize uintptr, flags int) (newfd int, err error) = SYS_SIGNALFD4
+
+// Signalfd creates a file descriptor from which the signals in sigmask
+// that are pending for the calling thread or process can be read, or
+// changes the mask of the signalfd fd if fd is not -1. flags is a
+// combination of SFD_CLOEXEC and SFD_NONBLOCK. The signals must be
+// blocked, see PthreadSigmask, so that they are not handled as usual.
+// Reads return SignalfdSiginfo records; see ParseSignalfdSiginfo.
+func Signalfd(fd int, sigmask *Sigset_t, flags int) (newfd int, err error) {
+	return signalfd(fd, sigmask, sizeofKernelSigset, flags)
+}
+
+//sys	timerCreate(clockid int, sevp *Sigevent, timerid *int32) (err error) = SYS_TIMER_CREATE
+
+// TimerCreate creates a POSIX per-process timer on the clock clockid and
+// returns its ID. sevp selects how the process is notified when the timer
+// expires: with the signal sevp.Signo and SIGEV_SIGNAL, or sent to the
+// thread sevp.Tid with SIGEV_THREAD_ID, or not at all with SIGEV_NONE. A
+// nil sevp sends SIGALRM with the timer ID as value. The timer is armed
+// with TimerSettime and must be deleted with TimerDelete.
+func TimerCreate(clockid int, sevp *Sigevent) (timerid int, err error) {
+	var id int32
+	err = timerCreate(clockid, sevp, &id)
+	return int(id), err
+}
+
+//sysnb	rtSigprocmask(how int, set *Sigset_t, oldset *Sigset_t, sigsetsize uintptr) (err error) = SYS_RT_SIGPROCMASK
+
+// PthreadSigmask changes the signal mask of the calling thread as
+// selected by how, one of SIG_BLOCK, SIG_UNBLOCK and SIG_SETMASK, with
+// set, unless set is nil, and stores the previous mask in oldset, unless
+// oldset is nil. Since the mask is per thread, the calling goroutine
+// should be locked to its thread with runtime.LockOSThread. Signals sent
+// to the whole process may still be delivered to the other threads of the
+// Go runtime, which handle them as usual.
+func PthreadSigmask(how int, set, oldset *Sigset_t) error {
+	if oldset != nil {
+		// The kernel only writes the first sizeofKernelSigset bytes.
+		*oldset = Sigset_t{}
+	}
+	return rtSigprocmask(how, set, oldset, sizeofKernelSigset)
+}
+
 // mmap varies by architecture; see syscall_linux_*.go.
 //sys	munmap(addr uintptr, length uintptr) (err error)
 
@@ -1597,10 +1740,6 @@ func Faccessat(dirfd int, path string, mode uint32, flags int) (err error) {
 // Alarm
 // ArchPrctl
 // Brk
-// Capget
-// Capset
-// ClockNanosleep
-// ClockSettime
 // Clone
 // EpollCtlOld
 // EpollPwait
@@ -1614,11 +1753,6 @@ func Faccessat(dirfd int, path string, mode uint32, flags int) (err error) {
 // GetThreadArea
 // Getitimer
 // Getpmsg
-// IoCancel
-// IoDestroy
-// IoGetevents
-// IoSetup
-// IoSubmit
 // IoprioGet
 // IoprioSet
 // KexecLoad
@@ -1678,16 +1812,9 @@ func Faccessat(dirfd int, path string, mode uint32, flags int) (err error) {
 // Shmdt
 // Shmget
 // Sigaltstack
-// Signalfd
 // Swapoff
 // Swapon
 // Sysfs
-// TimerCreate
-// TimerDelete
-// TimerGetoverrun
-// TimerGettime
-// TimerSettime
-// Timerfd
 // Tkill (obsolete)
 // Tuxcall
 // Umount2
@@ -1696,5 +1823,4 @@ func Faccessat(dirfd int, path string, mode uint32, flags int) (err error) {
 // Vfork
 // Vhangup
 // Vserver
-// Waitid
 // _Sysctl
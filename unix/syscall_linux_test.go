@@ -9,6 +9,7 @@ package unix_test
 import (
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"runtime"
 	"runtime/debug"
 	"testing"
@@ -482,3 +483,100 @@ func TestSyncFileRange(t *testing.T) {
 		t.Fatalf("SyncFileRange: unexpected error: %v, want EINVAL", err)
 	}
 }
+
+func TestPidfd(t *testing.T) {
+	cmd := exec.Command("sleep", "60")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("cannot start sleep: %v", err)
+	}
+	pid := cmd.Process.Pid
+	pidfd, err := unix.PidfdOpen(pid, unix.PIDFD_NONBLOCK)
+	if err == unix.ENOSYS || err == unix.EINVAL {
+		cmd.Process.Kill()
+		cmd.Wait()
+		t.Skip("pidfd_open syscall is not available, skipping test")
+	} else if err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		t.Fatalf("PidfdOpen: %v", err)
+	}
+	defer unix.Close(pidfd)
+
+	var info unix.Siginfo
+	err = unix.Waitid(unix.P_PIDFD, pidfd, &info, unix.WEXITED, nil)
+	if err != unix.EAGAIN {
+		t.Errorf("Waitid on a running process with PIDFD_NONBLOCK: %v, want EAGAIN", err)
+	}
+
+	// A pidfd becomes readable when the process exits.
+	fds := []unix.PollFd{{Fd: int32(pidfd), Events: unix.POLLIN}}
+	if n, err := unix.Poll(fds, 0); n != 0 || err != nil {
+		t.Errorf("Poll on a running process: %d, %v; want 0, nil", n, err)
+	}
+	epfd, err := unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		t.Fatalf("EpollCreate1: %v", err)
+	}
+	defer unix.Close(epfd)
+	ev := unix.EpollEvent{Events: unix.EPOLLIN, Fd: int32(pidfd)}
+	if err := unix.EpollCtl(epfd, unix.EPOLL_CTL_ADD, pidfd, &ev); err != nil {
+		t.Fatalf("EpollCtl: %v", err)
+	}
+
+	if err := unix.PidfdSendSignal(pidfd, unix.SIGKILL, nil, 0); err != nil {
+		t.Fatalf("PidfdSendSignal: %v", err)
+	}
+	if n, err := unix.Poll(fds, 5000); n != 1 || err != nil || fds[0].Revents&unix.POLLIN == 0 {
+		t.Errorf("Poll on an exited process: %d, %v, revents %#x", n, err, fds[0].Revents)
+	}
+	events := make([]unix.EpollEvent, 1)
+	if n, err := unix.EpollWait(epfd, events, 0); n != 1 || err != nil || events[0].Fd != int32(pidfd) {
+		t.Errorf("EpollWait on an exited process: %d, %v, %+v", n, err, events[0])
+	}
+
+	if err := unix.Waitid(unix.P_PIDFD, pidfd, &info, unix.WEXITED, nil); err != nil {
+		t.Fatalf("Waitid: %v", err)
+	}
+	if info.Signo != int32(unix.SIGCHLD) || info.Code != unix.CLD_KILLED ||
+		info.Pid != int32(pid) || info.Status != int32(unix.SIGKILL) {
+		t.Errorf("Waitid returned %+v, want SIGCHLD from %d killed by SIGKILL", info, pid)
+	}
+	if err := unix.PidfdSendSignal(pidfd, 0, nil, 0); err != unix.ESRCH {
+		t.Errorf("PidfdSendSignal to a reaped process: %v, want ESRCH", err)
+	}
+}
+
+func TestPidfdGetfd(t *testing.T) {
+	pidfd, err := unix.PidfdOpen(os.Getpid(), 0)
+	if err == unix.ENOSYS {
+		t.Skip("pidfd_open syscall is not available, skipping test")
+	} else if err != nil {
+		t.Fatalf("PidfdOpen: %v", err)
+	}
+	defer unix.Close(pidfd)
+
+	var p [2]int
+	if err := unix.Pipe2(p[:], unix.O_CLOEXEC); err != nil {
+		t.Fatalf("Pipe2: %v", err)
+	}
+	defer unix.Close(p[0])
+	defer unix.Close(p[1])
+
+	fd, err := unix.PidfdGetfd(pidfd, p[1], 0)
+	if err == unix.ENOSYS {
+		t.Skip("pidfd_getfd syscall is not available, skipping test")
+	} else if err != nil {
+		t.Fatalf("PidfdGetfd: %v", err)
+	}
+	defer unix.Close(fd)
+	if fd == p[1] {
+		t.Fatalf("PidfdGetfd returned the original descriptor %d", fd)
+	}
+	if _, err := unix.Write(fd, []byte("x")); err != nil {
+		t.Fatalf("Write to the duplicated descriptor: %v", err)
+	}
+	buf := make([]byte, 1)
+	if n, err := unix.Read(p[0], buf); n != 1 || err != nil || buf[0] != 'x' {
+		t.Errorf("Read: %q, %v", buf[:n], err)
+	}
+}
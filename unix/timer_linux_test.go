@@ -0,0 +1,199 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+
+package unix_test
+
+import (
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestItimerspecDuration(t *testing.T) {
+	its := unix.DurationToItimerspec(1500*time.Millisecond, 250*time.Microsecond)
+	if its.Value.Sec != 1 || its.Value.Nsec != 500000000 || its.Interval.Sec != 0 || its.Interval.Nsec != 250000 {
+		t.Errorf("DurationToItimerspec = %+v", its)
+	}
+	value, interval := unix.ItimerspecToDuration(its)
+	if value != 1500*time.Millisecond || interval != 250*time.Microsecond {
+		t.Errorf("ItimerspecToDuration = %v, %v; want 1.5s, 250µs", value, interval)
+	}
+}
+
+// readTimerfd reads the number of expirations of the timerfd fd.
+func readTimerfd(t *testing.T, fd int) uint64 {
+	var count uint64
+	if _, err := unix.Read(fd, (*[8]byte)(unsafe.Pointer(&count))[:]); err != nil {
+		t.Fatalf("reading timerfd: %v", err)
+	}
+	return count
+}
+
+func TestTimerfd(t *testing.T) {
+	fd, err := unix.TimerfdCreate(unix.CLOCK_MONOTONIC, unix.TFD_CLOEXEC|unix.TFD_NONBLOCK)
+	if err != nil {
+		t.Fatalf("TimerfdCreate: %v", err)
+	}
+	defer unix.Close(fd)
+
+	epfd, err := unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(epfd)
+	if err := unix.EpollCtl(epfd, unix.EPOLL_CTL_ADD, fd, &unix.EpollEvent{Events: unix.EPOLLIN, Fd: int32(fd)}); err != nil {
+		t.Fatal(err)
+	}
+	wait := func() {
+		events := make([]unix.EpollEvent, 1)
+		for {
+			n, err := unix.EpollWait(epfd, events, 5000)
+			if err == unix.EINTR {
+				continue
+			}
+			if err != nil || n != 1 {
+				t.Fatalf("EpollWait = %d, %v; want the timerfd to be ready", n, err)
+			}
+			return
+		}
+	}
+
+	// A periodic timer.
+	its := unix.DurationToItimerspec(10*time.Millisecond, 10*time.Millisecond)
+	if err := unix.TimerfdSettime(fd, 0, &its, nil); err != nil {
+		t.Fatalf("TimerfdSettime: %v", err)
+	}
+	wait()
+	if n := readTimerfd(t, fd); n < 1 {
+		t.Errorf("timerfd expired %d times, want at least 1", n)
+	}
+	var cur unix.Itimerspec
+	if err := unix.TimerfdGettime(fd, &cur); err != nil {
+		t.Fatalf("TimerfdGettime: %v", err)
+	}
+	if value, interval := unix.ItimerspecToDuration(cur); value <= 0 || value > 10*time.Millisecond || interval != 10*time.Millisecond {
+		t.Errorf("TimerfdGettime = %v, %v; want at most 10ms, 10ms", value, interval)
+	}
+
+	// A one-shot timer at an absolute time, which also disarms the first.
+	var now unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &now); err != nil {
+		t.Fatal(err)
+	}
+	its = unix.DurationToItimerspec(time.Duration(now.Nano())+20*time.Millisecond, 0)
+	var old unix.Itimerspec
+	if err := unix.TimerfdSettime(fd, unix.TFD_TIMER_ABSTIME, &its, &old); err != nil {
+		t.Fatalf("TimerfdSettime: %v", err)
+	}
+	if _, interval := unix.ItimerspecToDuration(old); interval != 10*time.Millisecond {
+		t.Errorf("old interval = %v, want 10ms", interval)
+	}
+	wait()
+	if n := readTimerfd(t, fd); n != 1 {
+		t.Errorf("timerfd expired %d times, want 1", n)
+	}
+	if err := unix.TimerfdGettime(fd, &cur); err != nil {
+		t.Fatalf("TimerfdGettime: %v", err)
+	}
+	if cur != (unix.Itimerspec{}) {
+		t.Errorf("one-shot timer still armed after expiring: %+v", cur)
+	}
+}
+
+func TestTimerfdCancelOnSet(t *testing.T) {
+	fd, err := unix.TimerfdCreate(unix.CLOCK_REALTIME, unix.TFD_CLOEXEC)
+	if err != nil {
+		t.Fatalf("TimerfdCreate: %v", err)
+	}
+	defer unix.Close(fd)
+	var now unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_REALTIME, &now); err != nil {
+		t.Fatal(err)
+	}
+	its := unix.DurationToItimerspec(time.Duration(now.Nano())+time.Hour, 0)
+	if err := unix.TimerfdSettime(fd, unix.TFD_TIMER_ABSTIME|unix.TFD_TIMER_CANCEL_ON_SET, &its, nil); err != nil {
+		t.Fatalf("TimerfdSettime with TFD_TIMER_CANCEL_ON_SET: %v", err)
+	}
+}
+
+func TestTimerfdBoottimeAlarm(t *testing.T) {
+	fd, err := unix.TimerfdCreate(unix.CLOCK_BOOTTIME_ALARM, unix.TFD_CLOEXEC)
+	switch err {
+	case nil:
+		unix.Close(fd)
+	case unix.EPERM:
+		t.Skip("CLOCK_BOOTTIME_ALARM requires the CAP_WAKE_ALARM capability")
+	case unix.EINVAL, unix.ENODEV, unix.EOPNOTSUPP:
+		t.Skipf("CLOCK_BOOTTIME_ALARM not supported: %v", err)
+	default:
+		t.Fatalf("TimerfdCreate(CLOCK_BOOTTIME_ALARM): %v", err)
+	}
+}
+
+func TestTimerCreate(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var set, old unix.Sigset_t
+	set.Add(unix.SIGUSR2)
+	if err := unix.PthreadSigmask(unix.SIG_BLOCK, &set, &old); err != nil {
+		t.Fatalf("PthreadSigmask: %v", err)
+	}
+	defer unix.PthreadSigmask(unix.SIG_SETMASK, &old, nil)
+	sfd, err := unix.Signalfd(-1, &set, unix.SFD_CLOEXEC)
+	if err != nil {
+		t.Fatalf("Signalfd: %v", err)
+	}
+	defer unix.Close(sfd)
+
+	sev := unix.Sigevent{
+		Value:  42,
+		Signo:  int32(unix.SIGUSR2),
+		Notify: unix.SIGEV_THREAD_ID,
+		Tid:    int32(unix.Gettid()),
+	}
+	id, err := unix.TimerCreate(unix.CLOCK_MONOTONIC, &sev)
+	if err != nil {
+		t.Fatalf("TimerCreate: %v", err)
+	}
+	defer func() {
+		if err := unix.TimerDelete(id); err != nil {
+			t.Errorf("TimerDelete: %v", err)
+		}
+	}()
+
+	its := unix.DurationToItimerspec(10*time.Millisecond, 0)
+	if err := unix.TimerSettime(id, 0, &its, nil); err != nil {
+		t.Fatalf("TimerSettime: %v", err)
+	}
+	buf := make([]byte, unix.SizeofSignalfdSiginfo)
+	n, err := unix.Read(sfd, buf)
+	if err != nil {
+		t.Fatalf("reading signalfd: %v", err)
+	}
+	infos, err := unix.ParseSignalfdSiginfo(buf[:n])
+	if err != nil || len(infos) != 1 {
+		t.Fatalf("ParseSignalfdSiginfo = %d records, %v", len(infos), err)
+	}
+	if info := infos[0]; syscall.Signal(info.Signo) != unix.SIGUSR2 || info.Ptr != 42 || int(info.Tid) != id {
+		t.Errorf("got signal %d with value %d from timer %d, want %d with 42 from %d", info.Signo, info.Ptr, info.Tid, unix.SIGUSR2, id)
+	}
+
+	if n, err := unix.TimerGetoverrun(id); err != nil || n != 0 {
+		t.Errorf("TimerGetoverrun = %d, %v; want 0", n, err)
+	}
+	var cur unix.Itimerspec
+	if err := unix.TimerGettime(id, &cur); err != nil {
+		t.Fatalf("TimerGettime: %v", err)
+	}
+	if cur != (unix.Itimerspec{}) {
+		t.Errorf("one-shot timer still armed after expiring: %+v", cur)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unix
+
+import "time"
+
+// DurationToItimerspec returns an Itimerspec that arms a timer to expire
+// after value and then every interval, or only once if interval is zero.
+// A zero value disarms the timer instead, so value must be positive. With
+// TFD_TIMER_ABSTIME or TIMER_ABSTIME, value is instead the time of the
+// first expiration on the clock of the timer.
+func DurationToItimerspec(value, interval time.Duration) Itimerspec {
+	return Itimerspec{
+		Interval: NsecToTimespec(int64(interval)),
+		Value:    NsecToTimespec(int64(value)),
+	}
+}
+
+// ItimerspecToDuration returns the time until the next expiration of a
+// timer, or zero if it is disarmed, and its interval, from an Itimerspec
+// returned by TimerGettime or TimerfdGettime.
+func ItimerspecToDuration(its Itimerspec) (value, interval time.Duration) {
+	return time.Duration(TimespecToNsec(its.Value)), time.Duration(TimespecToNsec(its.Interval))
+}
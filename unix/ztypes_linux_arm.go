@@ -95,6 +95,16 @@ type Rlimit struct {
 
 type _Gid_t uint32
 
+type Siginfo struct {
+	Signo  int32
+	Errno  int32
+	Code   int32
+	Pid    int32
+	Uid    uint32
+	Status int32
+	_      [104]int8
+}
+
 type Stat_t struct {
 	Dev     uint64
 	_       uint16
@@ -184,6 +194,48 @@ type FscryptKey struct {
 	Size uint32
 }
 
+type FscryptPolicyV2 struct {
+	Version                   uint8
+	Contents_encryption_mode  uint8
+	Filenames_encryption_mode uint8
+	Flags                     uint8
+	_                         [4]uint8
+	Master_key_identifier     [16]uint8
+}
+
+type FscryptGetPolicyExArg struct {
+	Size   uint64
+	Policy [24]byte
+}
+
+type FscryptKeySpecifier struct {
+	Type uint32
+	_    uint32
+	U    [32]byte
+}
+
+type FscryptAddKeyArg struct {
+	Key_spec FscryptKeySpecifier
+	Raw_size uint32
+	Key_id   uint32
+	_        [8]uint32
+}
+
+type FscryptRemoveKeyArg struct {
+	Key_spec             FscryptKeySpecifier
+	Removal_status_flags uint32
+	_                    [5]uint32
+}
+
+type FscryptGetKeyStatusArg struct {
+	Key_spec     FscryptKeySpecifier
+	_            [6]uint32
+	Status       uint32
+	Status_flags uint32
+	User_count   uint32
+	_            [13]uint32
+}
+
 type KeyctlDHParams struct {
 	Private int32
 	Prime   int32
@@ -720,6 +772,7 @@ const (
 	AT_EMPTY_PATH   = 0x1000
 	AT_FDCWD        = -0x64
 	AT_NO_AUTOMOUNT = 0x800
+	AT_RECURSIVE    = 0x8000
 	AT_REMOVEDIR    = 0x200
 
 	AT_STATX_SYNC_AS_STAT = 0x0
@@ -752,6 +805,8 @@ type Sigset_t struct {
 	Val [32]uint32
 }
 
+const _C__NSIG = 0x41
+
 const RNDGETENTCNT = 0x80045200
 
 const PERF_IOC_FLAG_GROUP = 0x1
@@ -1979,3 +2034,718 @@ const (
 	SOF_TIMESTAMPING_LAST = 0x4000
 	SOF_TIMESTAMPING_MASK = 0x7fff
 )
+
+type KernelTimespec struct {
+	Sec  int64
+	Nsec int64
+}
+
+type IoUringSqe struct {
+	Opcode       uint8
+	Flags        uint8
+	Ioprio       uint16
+	Fd           int32
+	Off          uint64
+	Addr         uint64
+	Len          uint32
+	Op_flags     uint32
+	User_data    uint64
+	Buf_index    uint16
+	Personality  uint16
+	Splice_fd_in int32
+	Addr3        uint64
+	_            [1]uint64
+}
+
+type IoUringCqe struct {
+	Data  uint64
+	Res   int32
+	Flags uint32
+}
+
+type IoSqringOffsets struct {
+	Head    uint32
+	Tail    uint32
+	Mask    uint32
+	Entries uint32
+	Flags   uint32
+	Dropped uint32
+	Array   uint32
+	Resv1   uint32
+	Resv2   uint64
+}
+
+type IoCqringOffsets struct {
+	Head     uint32
+	Tail     uint32
+	Mask     uint32
+	Entries  uint32
+	Overflow uint32
+	Cqes     uint32
+	Flags    uint32
+	Resv1    uint32
+	Resv2    uint64
+}
+
+type IoUringParams struct {
+	Sq_entries     uint32
+	Cq_entries     uint32
+	Flags          uint32
+	Sq_thread_cpu  uint32
+	Sq_thread_idle uint32
+	Features       uint32
+	Wq_fd          uint32
+	Resv           [3]uint32
+	Sq_off         IoSqringOffsets
+	Cq_off         IoCqringOffsets
+}
+
+const (
+	SizeofIoUringSqe    = 0x40
+	SizeofIoUringCqe    = 0x10
+	SizeofIoUringParams = 0x78
+)
+
+const (
+	IORING_OP_NOP             = 0x0
+	IORING_OP_READV           = 0x1
+	IORING_OP_WRITEV          = 0x2
+	IORING_OP_FSYNC           = 0x3
+	IORING_OP_READ_FIXED      = 0x4
+	IORING_OP_WRITE_FIXED     = 0x5
+	IORING_OP_POLL_ADD        = 0x6
+	IORING_OP_POLL_REMOVE     = 0x7
+	IORING_OP_SYNC_FILE_RANGE = 0x8
+	IORING_OP_SENDMSG         = 0x9
+	IORING_OP_RECVMSG         = 0xa
+	IORING_OP_TIMEOUT         = 0xb
+	IORING_OP_TIMEOUT_REMOVE  = 0xc
+	IORING_OP_ACCEPT          = 0xd
+	IORING_OP_ASYNC_CANCEL    = 0xe
+	IORING_OP_LINK_TIMEOUT    = 0xf
+	IORING_OP_CONNECT         = 0x10
+	IORING_OP_FALLOCATE       = 0x11
+	IORING_OP_OPENAT          = 0x12
+	IORING_OP_CLOSE           = 0x13
+	IORING_OP_FILES_UPDATE    = 0x14
+	IORING_OP_STATX           = 0x15
+	IORING_OP_READ            = 0x16
+	IORING_OP_WRITE           = 0x17
+	IORING_OP_FADVISE         = 0x18
+	IORING_OP_MADVISE         = 0x19
+	IORING_OP_SEND            = 0x1a
+	IORING_OP_RECV            = 0x1b
+	IORING_OP_OPENAT2         = 0x1c
+	IORING_OP_EPOLL_CTL       = 0x1d
+	IORING_OP_SPLICE          = 0x1e
+	IORING_OP_PROVIDE_BUFFERS = 0x1f
+	IORING_OP_REMOVE_BUFFERS  = 0x20
+	IORING_OP_TEE             = 0x21
+	IORING_OP_SHUTDOWN        = 0x22
+	IORING_OP_RENAMEAT        = 0x23
+	IORING_OP_UNLINKAT        = 0x24
+	IORING_OP_MKDIRAT         = 0x25
+	IORING_OP_SYMLINKAT       = 0x26
+	IORING_OP_LINKAT          = 0x27
+	IORING_OP_MSG_RING        = 0x28
+	IORING_OP_FSETXATTR       = 0x29
+	IORING_OP_SETXATTR        = 0x2a
+	IORING_OP_FGETXATTR       = 0x2b
+	IORING_OP_GETXATTR        = 0x2c
+	IORING_OP_SOCKET          = 0x2d
+	IORING_OP_URING_CMD       = 0x2e
+	IORING_OP_SEND_ZC         = 0x2f
+	IORING_OP_SENDMSG_ZC      = 0x30
+	IORING_OP_LAST            = 0x31
+)
+
+const (
+	IORING_REGISTER_BUFFERS          = 0x0
+	IORING_UNREGISTER_BUFFERS        = 0x1
+	IORING_REGISTER_FILES            = 0x2
+	IORING_UNREGISTER_FILES          = 0x3
+	IORING_REGISTER_EVENTFD          = 0x4
+	IORING_UNREGISTER_EVENTFD        = 0x5
+	IORING_REGISTER_FILES_UPDATE     = 0x6
+	IORING_REGISTER_EVENTFD_ASYNC    = 0x7
+	IORING_REGISTER_PROBE            = 0x8
+	IORING_REGISTER_PERSONALITY      = 0x9
+	IORING_UNREGISTER_PERSONALITY    = 0xa
+	IORING_REGISTER_RESTRICTIONS     = 0xb
+	IORING_REGISTER_ENABLE_RINGS     = 0xc
+	IORING_REGISTER_FILES2           = 0xd
+	IORING_REGISTER_FILES_UPDATE2    = 0xe
+	IORING_REGISTER_BUFFERS2         = 0xf
+	IORING_REGISTER_BUFFERS_UPDATE   = 0x10
+	IORING_REGISTER_IOWQ_AFF         = 0x11
+	IORING_UNREGISTER_IOWQ_AFF       = 0x12
+	IORING_REGISTER_IOWQ_MAX_WORKERS = 0x13
+	IORING_REGISTER_RING_FDS         = 0x14
+	IORING_UNREGISTER_RING_FDS       = 0x15
+	IORING_REGISTER_PBUF_RING        = 0x16
+	IORING_UNREGISTER_PBUF_RING      = 0x17
+	IORING_REGISTER_SYNC_CANCEL      = 0x18
+	IORING_REGISTER_FILE_ALLOC_RANGE = 0x19
+)
+
+type Iocb struct {
+	Data       uint64
+	Key        uint32
+	Rw_flags   int32
+	Lio_opcode uint16
+	Reqprio    int16
+	Fildes     uint32
+	Buf        uint64
+	Nbytes     uint64
+	Offset     int64
+	Reserved2  uint64
+	Flags      uint32
+	Resfd      uint32
+}
+
+type IoEvent struct {
+	Data uint64
+	Obj  uint64
+	Res  int64
+	Res2 int64
+}
+
+const (
+	SizeofIocb    = 0x40
+	SizeofIoEvent = 0x20
+)
+
+const (
+	IOCB_CMD_PREAD   = 0x0
+	IOCB_CMD_PWRITE  = 0x1
+	IOCB_CMD_FSYNC   = 0x2
+	IOCB_CMD_FDSYNC  = 0x3
+	IOCB_CMD_POLL    = 0x5
+	IOCB_CMD_NOOP    = 0x6
+	IOCB_CMD_PREADV  = 0x7
+	IOCB_CMD_PWRITEV = 0x8
+)
+
+type SeccompData struct {
+	Nr      int32
+	Arch    uint32
+	Pointer uint64
+	Args    [6]uint64
+}
+
+const SizeofSeccompData = 0x40
+
+type SeccompNotif struct {
+	Id    uint64
+	Pid   uint32
+	Flags uint32
+	Data  SeccompData
+}
+
+type SeccompNotifResp struct {
+	Id    uint64
+	Val   int64
+	Error int32
+	Flags uint32
+}
+
+type SeccompNotifAddfd struct {
+	Id          uint64
+	Flags       uint32
+	Srcfd       uint32
+	Newfd       uint32
+	Newfd_flags uint32
+}
+
+type SeccompNotifSizes struct {
+	Notif      uint16
+	Notif_resp uint16
+	Data       uint16
+}
+
+const (
+	SizeofSeccompNotif      = 0x50
+	SizeofSeccompNotifResp  = 0x18
+	SizeofSeccompNotifAddfd = 0x18
+)
+
+type BpfMapCreateAttr struct {
+	Map_type          uint32
+	Key_size          uint32
+	Value_size        uint32
+	Max_entries       uint32
+	Map_flags         uint32
+	Inner_map_fd      uint32
+	Numa_node         uint32
+	Map_name          [16]int8
+	Map_ifindex       uint32
+	Btf_fd            uint32
+	Btf_key_type_id   uint32
+	Btf_value_type_id uint32
+}
+
+type BpfMapElemAttr struct {
+	Fd    uint32
+	Key   uint64
+	Value uint64
+	Flags uint64
+}
+
+type BpfProgLoadAttr struct {
+	Prog_type            uint32
+	Insn_cnt             uint32
+	Insns                uint64
+	License              uint64
+	Log_level            uint32
+	Log_size             uint32
+	Log_buf              uint64
+	Kern_version         uint32
+	Prog_flags           uint32
+	Prog_name            [16]int8
+	Prog_ifindex         uint32
+	Expected_attach_type uint32
+}
+
+type BpfObjAttr struct {
+	Pathname   uint64
+	Bpf_fd     uint32
+	File_flags uint32
+}
+
+type BpfInfoAttr struct {
+	Bpf_fd   uint32
+	Info_len uint32
+	Info     uint64
+}
+
+type BpfMapInfo struct {
+	Type                      uint32
+	Id                        uint32
+	Key_size                  uint32
+	Value_size                uint32
+	Max_entries               uint32
+	Map_flags                 uint32
+	Name                      [16]int8
+	Ifindex                   uint32
+	Btf_vmlinux_value_type_id uint32
+	Netns_dev                 uint64
+	Netns_ino                 uint64
+	Btf_id                    uint32
+	Btf_key_type_id           uint32
+	Btf_value_type_id         uint32
+	_                         [4]byte
+	Map_extra                 uint64
+}
+
+type BpfInsn struct {
+	Code uint8
+	Regs uint8
+	Off  int16
+	Imm  int32
+}
+
+const (
+	SizeofBpfMapCreateAttr = 0x3c
+	SizeofBpfMapElemAttr   = 0x20
+	SizeofBpfProgLoadAttr  = 0x48
+	SizeofBpfObjAttr       = 0x10
+	SizeofBpfInfoAttr      = 0x10
+	SizeofBpfMapInfo       = 0x58
+	SizeofBpfInsn          = 0x8
+)
+
+const (
+	BPF_REG_0  = 0x0
+	BPF_REG_1  = 0x1
+	BPF_REG_2  = 0x2
+	BPF_REG_3  = 0x3
+	BPF_REG_4  = 0x4
+	BPF_REG_5  = 0x5
+	BPF_REG_6  = 0x6
+	BPF_REG_7  = 0x7
+	BPF_REG_8  = 0x8
+	BPF_REG_9  = 0x9
+	BPF_REG_10 = 0xa
+)
+
+const (
+	BPF_MAP_CREATE                  = 0x0
+	BPF_MAP_LOOKUP_ELEM             = 0x1
+	BPF_MAP_UPDATE_ELEM             = 0x2
+	BPF_MAP_DELETE_ELEM             = 0x3
+	BPF_MAP_GET_NEXT_KEY            = 0x4
+	BPF_PROG_LOAD                   = 0x5
+	BPF_OBJ_PIN                     = 0x6
+	BPF_OBJ_GET                     = 0x7
+	BPF_PROG_ATTACH                 = 0x8
+	BPF_PROG_DETACH                 = 0x9
+	BPF_PROG_TEST_RUN               = 0xa
+	BPF_PROG_RUN                    = 0xa
+	BPF_PROG_GET_NEXT_ID            = 0xb
+	BPF_MAP_GET_NEXT_ID             = 0xc
+	BPF_PROG_GET_FD_BY_ID           = 0xd
+	BPF_MAP_GET_FD_BY_ID            = 0xe
+	BPF_OBJ_GET_INFO_BY_FD          = 0xf
+	BPF_PROG_QUERY                  = 0x10
+	BPF_RAW_TRACEPOINT_OPEN         = 0x11
+	BPF_BTF_LOAD                    = 0x12
+	BPF_BTF_GET_FD_BY_ID            = 0x13
+	BPF_TASK_FD_QUERY               = 0x14
+	BPF_MAP_LOOKUP_AND_DELETE_ELEM  = 0x15
+	BPF_MAP_FREEZE                  = 0x16
+	BPF_BTF_GET_NEXT_ID             = 0x17
+	BPF_MAP_LOOKUP_BATCH            = 0x18
+	BPF_MAP_LOOKUP_AND_DELETE_BATCH = 0x19
+	BPF_MAP_UPDATE_BATCH            = 0x1a
+	BPF_MAP_DELETE_BATCH            = 0x1b
+	BPF_LINK_CREATE                 = 0x1c
+	BPF_LINK_UPDATE                 = 0x1d
+	BPF_LINK_GET_FD_BY_ID           = 0x1e
+	BPF_LINK_GET_NEXT_ID            = 0x1f
+	BPF_ENABLE_STATS                = 0x20
+	BPF_ITER_CREATE                 = 0x21
+	BPF_LINK_DETACH                 = 0x22
+	BPF_PROG_BIND_MAP               = 0x23
+)
+
+const (
+	BPF_MAP_TYPE_UNSPEC                = 0x0
+	BPF_MAP_TYPE_HASH                  = 0x1
+	BPF_MAP_TYPE_ARRAY                 = 0x2
+	BPF_MAP_TYPE_PROG_ARRAY            = 0x3
+	BPF_MAP_TYPE_PERF_EVENT_ARRAY      = 0x4
+	BPF_MAP_TYPE_PERCPU_HASH           = 0x5
+	BPF_MAP_TYPE_PERCPU_ARRAY          = 0x6
+	BPF_MAP_TYPE_STACK_TRACE           = 0x7
+	BPF_MAP_TYPE_CGROUP_ARRAY          = 0x8
+	BPF_MAP_TYPE_LRU_HASH              = 0x9
+	BPF_MAP_TYPE_LRU_PERCPU_HASH       = 0xa
+	BPF_MAP_TYPE_LPM_TRIE              = 0xb
+	BPF_MAP_TYPE_ARRAY_OF_MAPS         = 0xc
+	BPF_MAP_TYPE_HASH_OF_MAPS          = 0xd
+	BPF_MAP_TYPE_DEVMAP                = 0xe
+	BPF_MAP_TYPE_SOCKMAP               = 0xf
+	BPF_MAP_TYPE_CPUMAP                = 0x10
+	BPF_MAP_TYPE_XSKMAP                = 0x11
+	BPF_MAP_TYPE_SOCKHASH              = 0x12
+	BPF_MAP_TYPE_CGROUP_STORAGE        = 0x13
+	BPF_MAP_TYPE_REUSEPORT_SOCKARRAY   = 0x14
+	BPF_MAP_TYPE_PERCPU_CGROUP_STORAGE = 0x15
+	BPF_MAP_TYPE_QUEUE                 = 0x16
+	BPF_MAP_TYPE_STACK                 = 0x17
+	BPF_MAP_TYPE_SK_STORAGE            = 0x18
+	BPF_MAP_TYPE_DEVMAP_HASH           = 0x19
+	BPF_MAP_TYPE_STRUCT_OPS            = 0x1a
+	BPF_MAP_TYPE_RINGBUF               = 0x1b
+	BPF_MAP_TYPE_INODE_STORAGE         = 0x1c
+	BPF_MAP_TYPE_TASK_STORAGE          = 0x1d
+	BPF_MAP_TYPE_BLOOM_FILTER          = 0x1e
+	BPF_MAP_TYPE_USER_RINGBUF          = 0x1f
+)
+
+const (
+	BPF_PROG_TYPE_UNSPEC                  = 0x0
+	BPF_PROG_TYPE_SOCKET_FILTER           = 0x1
+	BPF_PROG_TYPE_KPROBE                  = 0x2
+	BPF_PROG_TYPE_SCHED_CLS               = 0x3
+	BPF_PROG_TYPE_SCHED_ACT               = 0x4
+	BPF_PROG_TYPE_TRACEPOINT              = 0x5
+	BPF_PROG_TYPE_XDP                     = 0x6
+	BPF_PROG_TYPE_PERF_EVENT              = 0x7
+	BPF_PROG_TYPE_CGROUP_SKB              = 0x8
+	BPF_PROG_TYPE_CGROUP_SOCK             = 0x9
+	BPF_PROG_TYPE_LWT_IN                  = 0xa
+	BPF_PROG_TYPE_LWT_OUT                 = 0xb
+	BPF_PROG_TYPE_LWT_XMIT                = 0xc
+	BPF_PROG_TYPE_SOCK_OPS                = 0xd
+	BPF_PROG_TYPE_SK_SKB                  = 0xe
+	BPF_PROG_TYPE_CGROUP_DEVICE           = 0xf
+	BPF_PROG_TYPE_SK_MSG                  = 0x10
+	BPF_PROG_TYPE_RAW_TRACEPOINT          = 0x11
+	BPF_PROG_TYPE_CGROUP_SOCK_ADDR        = 0x12
+	BPF_PROG_TYPE_LWT_SEG6LOCAL           = 0x13
+	BPF_PROG_TYPE_LIRC_MODE2              = 0x14
+	BPF_PROG_TYPE_SK_REUSEPORT            = 0x15
+	BPF_PROG_TYPE_FLOW_DISSECTOR          = 0x16
+	BPF_PROG_TYPE_CGROUP_SYSCTL           = 0x17
+	BPF_PROG_TYPE_RAW_TRACEPOINT_WRITABLE = 0x18
+	BPF_PROG_TYPE_CGROUP_SOCKOPT          = 0x19
+	BPF_PROG_TYPE_TRACING                 = 0x1a
+	BPF_PROG_TYPE_STRUCT_OPS              = 0x1b
+	BPF_PROG_TYPE_EXT                     = 0x1c
+	BPF_PROG_TYPE_LSM                     = 0x1d
+	BPF_PROG_TYPE_SK_LOOKUP               = 0x1e
+	BPF_PROG_TYPE_SYSCALL                 = 0x1f
+)
+
+const (
+	BPF_ANY     = 0x0
+	BPF_NOEXIST = 0x1
+	BPF_EXIST   = 0x2
+	BPF_F_LOCK  = 0x4
+)
+
+const (
+	BPF_F_NO_PREALLOC    = 0x1
+	BPF_F_NO_COMMON_LRU  = 0x2
+	BPF_F_NUMA_NODE      = 0x4
+	BPF_F_RDONLY         = 0x8
+	BPF_F_WRONLY         = 0x10
+	BPF_F_STACK_BUILD_ID = 0x20
+	BPF_F_ZERO_SEED      = 0x40
+	BPF_F_RDONLY_PROG    = 0x80
+	BPF_F_WRONLY_PROG    = 0x100
+	BPF_F_CLONE          = 0x200
+	BPF_F_MMAPABLE       = 0x400
+	BPF_F_PRESERVE_ELEMS = 0x800
+	BPF_F_INNER_MAP      = 0x1000
+)
+
+type CloneArgs struct {
+	Flags        uint64
+	Pidfd        uint64
+	Child_tid    uint64
+	Parent_tid   uint64
+	Exit_signal  uint64
+	Stack        uint64
+	Stack_size   uint64
+	Tls          uint64
+	Set_tid      uint64
+	Set_tid_size uint64
+	Cgroup       uint64
+}
+
+const SizeofCloneArgs = 0x58
+
+type MountAttr struct {
+	Attr_set    uint64
+	Attr_clr    uint64
+	Propagation uint64
+	Userns_fd   uint64
+}
+
+const SizeofMountAttr = 0x20
+
+const (
+	FSCONFIG_SET_FLAG        = 0x0
+	FSCONFIG_SET_STRING      = 0x1
+	FSCONFIG_SET_BINARY      = 0x2
+	FSCONFIG_SET_PATH        = 0x3
+	FSCONFIG_SET_PATH_EMPTY  = 0x4
+	FSCONFIG_SET_FD          = 0x5
+	FSCONFIG_CMD_CREATE      = 0x6
+	FSCONFIG_CMD_RECONFIGURE = 0x7
+)
+
+type OpenHow struct {
+	Flags   uint64
+	Mode    uint64
+	Resolve uint64
+}
+
+const SizeofOpenHow = 0x18
+
+type FanotifyEventMetadata struct {
+	Event_len    uint32
+	Vers         uint8
+	Reserved     uint8
+	Metadata_len uint16
+	Mask         uint64
+	Fd           int32
+	Pid          int32
+}
+
+type FanotifyEventInfoHeader struct {
+	Type uint8
+	Pad  uint8
+	Len  uint16
+}
+
+type FanotifyEventInfoFid struct {
+	Hdr  FanotifyEventInfoHeader
+	Fsid Fsid
+}
+
+type FanotifyResponse struct {
+	Fd       int32
+	Response uint32
+}
+
+const (
+	SizeofFanotifyEventMetadata   = 0x18
+	SizeofFanotifyEventInfoHeader = 0x4
+	SizeofFanotifyEventInfoFid    = 0xc
+	SizeofFanotifyResponse        = 0x8
+)
+
+type FileCloneRange struct {
+	Src_fd      int64
+	Src_offset  uint64
+	Src_length  uint64
+	Dest_offset uint64
+}
+
+type RawFileDedupeRange struct {
+	Src_offset uint64
+	Src_length uint64
+	Dest_count uint16
+	Reserved1  uint16
+	Reserved2  uint32
+}
+
+type RawFileDedupeRangeInfo struct {
+	Dest_fd       int64
+	Dest_offset   uint64
+	Bytes_deduped uint64
+	Status        int32
+	Reserved      uint32
+}
+
+type Fiemap struct {
+	Start          uint64
+	Length         uint64
+	Flags          uint32
+	Mapped_extents uint32
+	Extent_count   uint32
+	Reserved       uint32
+}
+
+type FiemapExtent struct {
+	Logical    uint64
+	Physical   uint64
+	Length     uint64
+	Reserved64 [2]uint64
+	Flags      uint32
+	Reserved   [3]uint32
+}
+
+const (
+	SizeofRawFileDedupeRange     = 0x18
+	SizeofRawFileDedupeRangeInfo = 0x20
+	SizeofFiemap                 = 0x20
+	SizeofFiemapExtent           = 0x38
+)
+
+type FsverityEnableArg struct {
+	Version        uint32
+	Hash_algorithm uint32
+	Block_size     uint32
+	Salt_size      uint32
+	Salt_ptr       uint64
+	Sig_size       uint32
+	_              uint32
+	Sig_ptr        uint64
+	_              [11]uint64
+}
+
+type RawFsverityDigest struct {
+	Algorithm uint16
+	Size      uint16
+}
+
+const (
+	SizeofFsverityEnableArg = 0x80
+	SizeofRawFsverityDigest = 0x4
+)
+
+type LoopInfo64 struct {
+	Device           uint64
+	Inode            uint64
+	Rdevice          uint64
+	Offset           uint64
+	Sizelimit        uint64
+	Number           uint32
+	Encrypt_type     uint32
+	Encrypt_key_size uint32
+	Flags            uint32
+	File_name        [64]uint8
+	Crypt_name       [64]uint8
+	Encrypt_key      [32]uint8
+	Init             [2]uint64
+}
+
+type LoopConfig struct {
+	Fd   uint32
+	Size uint32
+	Info LoopInfo64
+	_    [8]uint64
+}
+
+const (
+	SizeofLoopInfo64 = 0xe8
+	SizeofLoopConfig = 0x130
+)
+
+const (
+	LO_FLAGS_READ_ONLY = 0x1
+	LO_FLAGS_AUTOCLEAR = 0x4
+	LO_FLAGS_PARTSCAN  = 0x8
+	LO_FLAGS_DIRECT_IO = 0x10
+)
+
+type SignalfdSiginfo struct {
+	Signo     uint32
+	Errno     int32
+	Code      int32
+	Pid       uint32
+	Uid       uint32
+	Fd        int32
+	Tid       uint32
+	Band      uint32
+	Overrun   uint32
+	Trapno    uint32
+	Status    int32
+	Int       int32
+	Ptr       uint64
+	Utime     uint64
+	Stime     uint64
+	Addr      uint64
+	Addr_lsb  uint16
+	_         uint16
+	Syscall   int32
+	Call_addr uint64
+	Arch      uint32
+	_         [28]uint8
+}
+
+const SizeofSignalfdSiginfo = 0x80
+
+type Itimerspec struct {
+	Interval Timespec
+	Value    Timespec
+}
+
+type Sigevent struct {
+	Value  uint32
+	Signo  int32
+	Notify int32
+	Tid    int32
+	_      [48]uint8
+}
+
+const (
+	SIGEV_SIGNAL    = 0x0
+	SIGEV_NONE      = 0x1
+	SIGEV_THREAD    = 0x2
+	SIGEV_THREAD_ID = 0x4
+)
+
+type CapUserHeader struct {
+	Version uint32
+	Pid     int32
+}
+
+type CapUserData struct {
+	Effective   uint32
+	Permitted   uint32
+	Inheritable uint32
+}
+
+const (
+	LINUX_CAPABILITY_VERSION_1 = 0x19980330
+	LINUX_CAPABILITY_VERSION_2 = 0x20071026
+	LINUX_CAPABILITY_VERSION_3 = 0x20080522
+	LINUX_CAPABILITY_U32S_1    = 0x1
+	LINUX_CAPABILITY_U32S_2    = 0x2
+	LINUX_CAPABILITY_U32S_3    = 0x2
+)